@@ -30,7 +30,8 @@ func NewAccount(entityManager persistence.EntityManager) *Account {
 //
 //	@Router		/accounts/{address}/votes [GET]
 //
-//	@Param		address			path		string		true	"Hash or PublicKey"	maxlength(66)
+//	@Param		address			path		string		true	"Hash or PublicKey"													maxlength(66)
+//	@Param		stake_type_id	query		[]int		false	"Comma-separated list of stake type ids (1 - reputation, 2 - CSPR)"	collectionFormat(csv)
 //	@Param		includes		query		string		false	"Optional fields' schema (voting{})"
 //	@Param		page			query		int			false	"Page number"													default(1)
 //	@Param		page_size		query		string		false	"Number of items per page"										default(10)
@@ -54,6 +55,12 @@ func (h *Account) HandleGetAccountVotes(w http.ResponseWriter, r *http.Request)
 		addressHash = &accountHash.Hash
 	}
 
+	stakeTypeIDs, err := parseStakeTypeIDs(r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
 	includes, err := http_params.ParseOptionalData("includes", r)
 	if err != nil {
 		http_response.Error(w, r, err)
@@ -64,6 +71,7 @@ func (h *Account) HandleGetAccountVotes(w http.ResponseWriter, r *http.Request)
 
 	getVotes := votes.NewGetVotes()
 	getVotes.SetAddress(addressHash)
+	getVotes.SetStakeTypeIDs(stakeTypeIDs)
 	getVotes.SetEntityManager(h.entityManager)
 	getVotes.SetPaginationParams(paginationParams)
 
@@ -96,7 +104,7 @@ func (h *Account) HandleGetAccountVotes(w http.ResponseWriter, r *http.Request)
 //	@Success	200			{object}	http_response.PaginatedResponse{data=entities.Account}
 //	@Failure	400,404,500	{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
 //
-//	@tags		Vote
+//	@tags		Account
 func (h *Account) HandleGetAccounts(w http.ResponseWriter, r *http.Request) {
 	paginationParams := pagination.NewParamsFromRequest(r)
 
@@ -118,7 +126,7 @@ func (h *Account) HandleGetAccounts(w http.ResponseWriter, r *http.Request) {
 //	@Success	200			{object}	http_response.SuccessResponse{data=entities.Account}
 //	@Failure	400,404,500	{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
 //
-//	@tags		Vote
+//	@tags		Account
 func (h *Account) HandleGetAccountsByAddress(w http.ResponseWriter, r *http.Request) {
 	addressHash, err := http_params.ParseOptionalHash("address", r)
 	if err != nil {
@@ -137,3 +145,73 @@ func (h *Account) HandleGetAccountsByAddress(w http.ResponseWriter, r *http.Requ
 
 	http_response.FromFunction(getAccount.Execute, w, r)
 }
+
+// HandleGetAccountPendingActions
+//
+//	@Summary	Return votings the account has not voted in and jobs waiting for the account action ordered by deadline
+//
+//	@Router		/accounts/{address}/pending  [GET]
+//
+//	@Param		address		path		string	true	"Hash or PublicKey"	maxlength(66)
+//
+//	@Success	200			{object}	http_response.SuccessResponse{data=[]entities.PendingAction}
+//	@Failure	400,404,500	{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Account
+func (h *Account) HandleGetAccountPendingActions(w http.ResponseWriter, r *http.Request) {
+	addressHash, err := http_params.ParseOptionalHash("address", r)
+	if err != nil {
+		accountPubKey, err := http_params.ParseOptionalPublicKey("address", r)
+		if err != nil {
+			http_response.Error(w, r, errors.NewInvalidInputError("Account address is not a valid account hash or public key"))
+			return
+		}
+		accountHash := accountPubKey.AccountHash()
+		addressHash = &accountHash.Hash
+	}
+
+	getPendingActions := account.NewGetAccountPendingActions()
+	getPendingActions.SetAddress(*addressHash)
+	getPendingActions.SetEntityManager(h.entityManager)
+
+	http_response.FromFunction(getPendingActions.Execute, w, r)
+}
+
+// HandleGetAccountActivity
+//
+//	@Summary	Return paginated time-ordered list of the account activities of all kinds
+//
+//	@Router		/accounts/{address}/activity  [GET]
+//
+//	@Param		address			path		string		true	"Hash or PublicKey"										maxlength(66)
+//	@Param		page			query		int			false	"Page number"											default(1)
+//	@Param		page_size		query		string		false	"Number of items per page"								default(10)
+//	@Param		order_direction	query		string		false	"Sorting direction"										Enums(ASC, DESC)		default(DESC)
+//	@Param		order_by		query		[]string	false	"Comma-separated list of sorting fields (timestamp)"	collectionFormat(csv)	default(timestamp)
+//
+//	@Success	200				{object}	http_response.PaginatedResponse{data=entities.AccountActivity}
+//	@Failure	400,404,500		{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Account
+func (h *Account) HandleGetAccountActivity(w http.ResponseWriter, r *http.Request) {
+	addressHash, err := http_params.ParseOptionalHash("address", r)
+	if err != nil {
+		accountPubKey, err := http_params.ParseOptionalPublicKey("address", r)
+		if err != nil {
+			http_response.Error(w, r, errors.NewInvalidInputError("Account address is not a valid account hash or public key"))
+			return
+		}
+		accountHash := accountPubKey.AccountHash()
+		addressHash = &accountHash.Hash
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("timestamp", pagination.OrderDirectionDESC)
+
+	getAccountActivity := account.NewGetAccountActivity()
+	getAccountActivity.SetAddress(*addressHash)
+	getAccountActivity.SetEntityManager(h.entityManager)
+	getAccountActivity.SetPaginationParams(paginationParams)
+
+	http_response.FromFunction(getAccountActivity.Execute, w, r)
+}
@@ -3,13 +3,17 @@ package handlers
 import (
 	"net/http"
 
+	"casper-dao-middleware/apps/api/serialization"
+	"casper-dao-middleware/internal/dao/entities"
 	"casper-dao-middleware/internal/dao/persistence"
 	"casper-dao-middleware/internal/dao/services/bid"
 	"casper-dao-middleware/internal/dao/services/job_offer"
 	"casper-dao-middleware/internal/dao/services/jobs"
+	"casper-dao-middleware/pkg/errors"
 	http_params "casper-dao-middleware/pkg/http-params"
 	http_response "casper-dao-middleware/pkg/http-response"
 	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/serialize"
 )
 
 type JobOffer struct {
@@ -24,29 +28,195 @@ func NewJobOffer(entityManager persistence.EntityManager) *JobOffer {
 
 // HandleGetJobOffers
 //
-//	@Summary	Return paginated list of votes for votingID
+//	@Summary	Return paginated list of job offers
 //
 //	@Router		/job-offers [GET]
 //
-//	@Param		page			query		int			false	"Page number"											default(1)
-//	@Param		page_size		query		string		false	"Number of items per page"								default(10)
-//	@Param		order_direction	query		string		false	"Sorting direction"										Enums(ASC, DESC)		default(ASC)
-//	@Param		order_by		query		[]string	false	"Comma-separated list of sorting fields (job_offer_id)"	collectionFormat(csv)	default(voting_id)
+//	@Param		job_poster					query		string		false	"JobPoster Hash or PublicKey"										maxlength(66)
+//	@Param		auction_type_id				query		[]int		false	"Comma-separated list of auction type ids (1-Internal,2-External)"	collectionFormat(csv)
+//	@Param		max_budget_from				query		int			false	"Minimal max budget"
+//	@Param		max_budget_to				query		int			false	"Maximal max budget"
+//	@Param		expected_time_frame_from	query		int			false	"Minimal expected time frame"
+//	@Param		expected_time_frame_to		query		int			false	"Maximal expected time frame"
+//	@Param		from						query		string		false	"Job offers created after the time (RFC3339)"
+//	@Param		to							query		string		false	"Job offers created before the time (RFC3339)"
+//	@Param		state						query		string		false	"Job offer state"																					Enums(open, has_job)
+//	@Param		job_status_id				query		[]int		false	"Comma-separated list of job status ids of the job offer job"										collectionFormat(csv)
+//	@Param		page						query		int			false	"Page number"																						default(1)
+//	@Param		page_size					query		string		false	"Number of items per page"																			default(10)
+//	@Param		order_direction				query		string		false	"Sorting direction"																					Enums(ASC, DESC)		default(ASC)
+//	@Param		order_by					query		[]string	false	"Comma-separated list of sorting fields (job_offer_id,max_budget,expected_time_frame,timestamp)"	collectionFormat(csv)	default(job_offer_id)
 //
-//	@Success	200				{object}	http_response.PaginatedResponse{data=entities.JobOffer}
-//	@Failure	400,404,500		{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//	@Success	200							{object}	http_response.PaginatedResponse{data=entities.JobOffer}
+//	@Failure	400,404,500					{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
 //
 //	@tags		BidEscrow
 func (h *JobOffer) HandleGetJobOffers(w http.ResponseWriter, r *http.Request) {
+	jobPoster, err := http_params.ParseOptionalHash("job_poster", r)
+	if err != nil {
+		jobPosterPubKey, err := http_params.ParseOptionalPublicKey("job_poster", r)
+		if err != nil {
+			http_response.Error(w, r, errors.NewInvalidInputError("JobPoster is not a valid account hash or public key"))
+			return
+		}
+		jobPosterHash := jobPosterPubKey.AccountHash()
+		jobPoster = &jobPosterHash.Hash
+	}
+
+	rawAuctionTypeIDs, err := http_params.ParseOptionalUint16List("auction_type_id", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	auctionTypeIDs := make([]entities.AuctionTypeID, 0, len(rawAuctionTypeIDs))
+	for _, auctionTypeID := range rawAuctionTypeIDs {
+		auctionTypeIDs = append(auctionTypeIDs, entities.AuctionTypeID(auctionTypeID))
+	}
+
+	maxBudgetFrom, err := http_params.ParseOptionalUint64("max_budget_from", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	maxBudgetTo, err := http_params.ParseOptionalUint64("max_budget_to", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	expectedTimeFrameFrom, err := http_params.ParseOptionalUint64("expected_time_frame_from", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	expectedTimeFrameTo, err := http_params.ParseOptionalUint64("expected_time_frame_to", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	from, err := http_params.ParseOptionalTime("from", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	to, err := http_params.ParseOptionalTime("to", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	rawState, err := http_params.ParseOptionalString("state", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	var state *entities.JobOfferState
+	if rawState != nil {
+		jobOfferState := entities.JobOfferState(*rawState)
+		if jobOfferState != entities.JobOfferStateOpen && jobOfferState != entities.JobOfferStateHasJob {
+			http_response.Error(w, r, errors.NewInvalidInputError("Invalid `state` value, expected open or has_job"))
+			return
+		}
+		state = &jobOfferState
+	}
+
+	rawJobStatusIDs, err := http_params.ParseOptionalUint16List("job_status_id", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	jobStatusIDs := make([]entities.JobStatusID, 0, len(rawJobStatusIDs))
+	for _, jobStatusID := range rawJobStatusIDs {
+		jobStatusIDs = append(jobStatusIDs, entities.JobStatusID(jobStatusID))
+	}
+
 	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("job_offer_id", pagination.OrderDirectionASC)
 
 	getJobOffers := job_offer.NewGetJobOffers()
 	getJobOffers.SetEntityManager(h.entityManager)
 	getJobOffers.SetPaginationParams(paginationParams)
+	getJobOffers.SetJobPoster(jobPoster)
+	getJobOffers.SetAuctionTypeIDs(auctionTypeIDs)
+	getJobOffers.SetMaxBudgetRange(maxBudgetFrom, maxBudgetTo)
+	getJobOffers.SetExpectedTimeFrameRange(expectedTimeFrameFrom, expectedTimeFrameTo)
+	getJobOffers.SetFrom(from)
+	getJobOffers.SetTo(to)
+	getJobOffers.SetState(state)
+	getJobOffers.SetJobStatusIDs(jobStatusIDs)
 
 	http_response.FromFunction(getJobOffers.Execute, w, r)
 }
 
+// HandleGetJobOfferByID
+//
+//	@Summary	Return JobOffer by JobOfferID
+//
+//	@Router		/job-offers/{job_offer_id} [GET]
+//
+//	@Param		job_offer_id	path		uint	true	"JobOfferID uint"
+//	@Param		includes		query		string	false	"Optional fields' schema (bids{}, job{}, job_history{}, voting{})"
+//
+//	@Success	200				{object}	http_response.SuccessResponse{data=entities.JobOffer}
+//	@Failure	400,404,500		{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		BidEscrow
+func (h *JobOffer) HandleGetJobOfferByID(w http.ResponseWriter, r *http.Request) {
+	jobOfferID, err := http_params.ParseUint32("job_offer_id", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	includes, err := http_params.ParseOptionalData("includes", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	getJobOffer := job_offer.NewGetJobOfferByID()
+	getJobOffer.SetEntityManager(h.entityManager)
+	getJobOffer.SetJobOfferID(jobOfferID)
+
+	jobOffer, err := getJobOffer.Execute()
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	// includers work with the list of entities
+	jobOffersJSON := []map[string]interface{}{serialize.ToRawJSON(jobOffer)}
+
+	if _, ok := includes.Contains("bids"); ok {
+		bidsIncluder := serialization.NewJobOfferBidsIncluder(jobOffersJSON, h.entityManager)
+		bidsIncluder.Include("job_offer_id")
+	}
+
+	if optionalJobData, ok := includes.Contains("job"); ok {
+		jobIncluder := serialization.NewJobOfferJobIncluder(jobOffersJSON, h.entityManager)
+		jobIncluder.Include(optionalJobData, "job_offer_id")
+	}
+
+	if _, ok := includes.Contains("job_history"); ok {
+		jobHistoryIncluder := serialization.NewJobOfferJobHistoryIncluder(jobOffersJSON, h.entityManager)
+		jobHistoryIncluder.Include("job_offer_id")
+	}
+
+	if optionalVotingData, ok := includes.Contains("voting"); ok {
+		votingIncluder := serialization.NewJobOfferVotingIncluder(jobOffersJSON, h.entityManager)
+		votingIncluder.Include(optionalVotingData, "job_offer_id")
+	}
+
+	http_response.Success(w, jobOffersJSON[0])
+}
+
 // HandleGetJobOfferBids
 //
 //	@Summary	Return paginated list of bid for JobOffer
@@ -75,7 +245,7 @@ func (h *JobOffer) HandleGetJobOfferBids(w http.ResponseWriter, r *http.Request)
 	getBids := bid.NewGetBids()
 	getBids.SetEntityManager(h.entityManager)
 	getBids.SetPaginationParams(paginationParams)
-	getBids.SetJobOfferID(jobOfferID)
+	getBids.SetJobOfferIDs([]uint32{jobOfferID})
 
 	//TODO: make sense to add job offer including
 
@@ -134,6 +304,346 @@ func (h *JobOffer) HandleGetJobByID(w http.ResponseWriter, r *http.Request) {
 	http_response.FromFunction(getJobByID.Execute, w, r)
 }
 
+// HandleGetJobHistory
+//
+//	@Summary	Return paginated list of Job status transitions
+//
+//	@Router		/jobs/{job_id}/history [GET]
+//
+//	@Param		job_id			path		uint		true	"JobID uint"
+//	@Param		page			query		int			false	"Page number"											default(1)
+//	@Param		page_size		query		string		false	"Number of items per page"								default(10)
+//	@Param		order_direction	query		string		false	"Sorting direction"										Enums(ASC, DESC)		default(ASC)
+//	@Param		order_by		query		[]string	false	"Comma-separated list of sorting fields (timestamp)"	collectionFormat(csv)	default(timestamp)
+//
+//	@Success	200				{object}	http_response.PaginatedResponse{data=entities.JobStatusChange}
+//	@Failure	400,404,500		{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		BidEscrow
+func (h *JobOffer) HandleGetJobHistory(w http.ResponseWriter, r *http.Request) {
+	jobID, err := http_params.ParseUint32("job_id", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("timestamp", pagination.OrderDirectionASC)
+
+	getJobHistory := jobs.NewGetJobHistory()
+	getJobHistory.SetEntityManager(h.entityManager)
+	getJobHistory.SetPaginationParams(paginationParams)
+	getJobHistory.SetJobID(jobID)
+
+	http_response.FromFunction(getJobHistory.Execute, w, r)
+}
+
+// HandleGetAccountJobOffers
+//
+//	@Summary	Return paginated list of job offers posted by account
+//
+//	@Router		/accounts/{address}/job-offers [GET]
+//
+//	@Param		address			path		string		true	"Hash or PublicKey"																maxlength(66)
+//	@Param		page			query		int			false	"Page number"																	default(1)
+//	@Param		page_size		query		string		false	"Number of items per page"														default(10)
+//	@Param		order_direction	query		string		false	"Sorting direction"																Enums(ASC, DESC)		default(DESC)
+//	@Param		order_by		query		[]string	false	"Comma-separated list of sorting fields (job_offer_id,max_budget,timestamp)"	collectionFormat(csv)	default(job_offer_id)
+//
+//	@Success	200				{object}	http_response.PaginatedResponse{data=entities.JobOffer}
+//	@Failure	400,404,500		{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		BidEscrow
+func (h *JobOffer) HandleGetAccountJobOffers(w http.ResponseWriter, r *http.Request) {
+	addressHash, err := http_params.ParseOptionalHash("address", r)
+	if err != nil {
+		accountPubKey, err := http_params.ParseOptionalPublicKey("address", r)
+		if err != nil {
+			http_response.Error(w, r, errors.NewInvalidInputError("Account address is not a valid account hash or public key"))
+			return
+		}
+		accountHash := accountPubKey.AccountHash()
+		addressHash = &accountHash.Hash
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("job_offer_id", pagination.OrderDirectionDESC)
+
+	getAccountJobOffers := job_offer.NewGetAccountJobOffers()
+	getAccountJobOffers.SetEntityManager(h.entityManager)
+	getAccountJobOffers.SetPaginationParams(paginationParams)
+	getAccountJobOffers.SetAddress(*addressHash)
+
+	http_response.FromFunction(getAccountJobOffers.Execute, w, r)
+}
+
+// HandleGetAccountBids
+//
+//	@Summary	Return paginated list of bids submitted by account
+//
+//	@Router		/accounts/{address}/bids [GET]
+//
+//	@Param		address			path		string		true	"Hash or PublicKey"	maxlength(66)
+//	@Param		is_picked		query		bool		false	"Picked/not picked by job poster bids filtering"
+//	@Param		page			query		int			false	"Page number"												default(1)
+//	@Param		page_size		query		string		false	"Number of items per page"									default(10)
+//	@Param		order_direction	query		string		false	"Sorting direction"											Enums(ASC, DESC)		default(DESC)
+//	@Param		order_by		query		[]string	false	"Comma-separated list of sorting fields (bid_id,timestamp)"	collectionFormat(csv)	default(bid_id)
+//
+//	@Success	200				{object}	http_response.PaginatedResponse{data=entities.Bid}
+//	@Failure	400,404,500		{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		BidEscrow
+func (h *JobOffer) HandleGetAccountBids(w http.ResponseWriter, r *http.Request) {
+	addressHash, err := http_params.ParseOptionalHash("address", r)
+	if err != nil {
+		accountPubKey, err := http_params.ParseOptionalPublicKey("address", r)
+		if err != nil {
+			http_response.Error(w, r, errors.NewInvalidInputError("Account address is not a valid account hash or public key"))
+			return
+		}
+		accountHash := accountPubKey.AccountHash()
+		addressHash = &accountHash.Hash
+	}
+
+	isPicked, err := http_params.ParseOptionalBool("is_picked", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("bid_id", pagination.OrderDirectionDESC)
+
+	getAccountBids := bid.NewGetAccountBids()
+	getAccountBids.SetEntityManager(h.entityManager)
+	getAccountBids.SetPaginationParams(paginationParams)
+	getAccountBids.SetAddress(*addressHash)
+	getAccountBids.SetIsPicked(isPicked)
+
+	http_response.FromFunction(getAccountBids.Execute, w, r)
+}
+
+// HandleGetAccountJobs
+//
+//	@Summary	Return paginated list of jobs where account is job poster or worker
+//
+//	@Router		/accounts/{address}/jobs [GET]
+//
+//	@Param		address			path		string		true	"Hash or PublicKey"											maxlength(66)
+//	@Param		role			query		string		false	"Account role in the job, any role by default"				Enums(poster, worker)
+//	@Param		job_status_id	query		[]int		false	"Comma-separated list of job status ids"					collectionFormat(csv)
+//	@Param		page			query		int			false	"Page number"												default(1)
+//	@Param		page_size		query		string		false	"Number of items per page"									default(10)
+//	@Param		order_direction	query		string		false	"Sorting direction"											Enums(ASC, DESC)		default(DESC)
+//	@Param		order_by		query		[]string	false	"Comma-separated list of sorting fields (job_id,timestamp)"	collectionFormat(csv)	default(job_id)
+//
+//	@Success	200				{object}	http_response.PaginatedResponse{data=entities.Job}
+//	@Failure	400,404,500		{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		BidEscrow
+func (h *JobOffer) HandleGetAccountJobs(w http.ResponseWriter, r *http.Request) {
+	addressHash, err := http_params.ParseOptionalHash("address", r)
+	if err != nil {
+		accountPubKey, err := http_params.ParseOptionalPublicKey("address", r)
+		if err != nil {
+			http_response.Error(w, r, errors.NewInvalidInputError("Account address is not a valid account hash or public key"))
+			return
+		}
+		accountHash := accountPubKey.AccountHash()
+		addressHash = &accountHash.Hash
+	}
+
+	rawRole, err := http_params.ParseOptionalString("role", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	var role *entities.JobRole
+	if rawRole != nil {
+		jobRole := entities.JobRole(*rawRole)
+		if jobRole != entities.JobRolePoster && jobRole != entities.JobRoleWorker {
+			http_response.Error(w, r, errors.NewInvalidInputError("Invalid `role` value, expected poster or worker"))
+			return
+		}
+		role = &jobRole
+	}
+
+	rawJobStatusIDs, err := http_params.ParseOptionalUint16List("job_status_id", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	jobStatusIDs := make([]entities.JobStatusID, 0, len(rawJobStatusIDs))
+	for _, jobStatusID := range rawJobStatusIDs {
+		jobStatusIDs = append(jobStatusIDs, entities.JobStatusID(jobStatusID))
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("job_id", pagination.OrderDirectionDESC)
+
+	getAccountJobs := jobs.NewGetAccountJobs()
+	getAccountJobs.SetEntityManager(h.entityManager)
+	getAccountJobs.SetPaginationParams(paginationParams)
+	getAccountJobs.SetAddress(*addressHash)
+	getAccountJobs.SetRole(role)
+	getAccountJobs.SetJobStatusIDs(jobStatusIDs)
+
+	http_response.FromFunction(getAccountJobs.Execute, w, r)
+}
+
+// HandleGetAccountBidEscrowStats
+//
+//	@Summary	Return BidEscrow performance statistics of account as worker and job poster
+//
+//	@Router		/accounts/{address}/bid-escrow-stats [GET]
+//
+//	@Param		address		path		string	true	"Hash or PublicKey"	maxlength(66)
+//
+//	@Success	200			{object}	http_response.SuccessResponse{data=entities.BidEscrowStats}
+//	@Failure	400,404,500	{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		BidEscrow
+func (h *JobOffer) HandleGetAccountBidEscrowStats(w http.ResponseWriter, r *http.Request) {
+	addressHash, err := http_params.ParseOptionalHash("address", r)
+	if err != nil {
+		accountPubKey, err := http_params.ParseOptionalPublicKey("address", r)
+		if err != nil {
+			http_response.Error(w, r, errors.NewInvalidInputError("Account address is not a valid account hash or public key"))
+			return
+		}
+		accountHash := accountPubKey.AccountHash()
+		addressHash = &accountHash.Hash
+	}
+
+	getStats := jobs.NewGetBidEscrowStats()
+	getStats.SetEntityManager(h.entityManager)
+	getStats.SetAddress(*addressHash)
+
+	http_response.FromFunction(getStats.Execute, w, r)
+}
+
+// HandleGetJobPayments
+//
+//	@Summary	Return paginated list of CSPR flows of the Job
+//
+//	@Router		/jobs/{job_id}/payments [GET]
+//
+//	@Param		job_id				path		uint		true	"JobID uint"
+//	@Param		cspr_flow_type_id	query		[]int		false	"Comma-separated list of flow type ids (1 - payment deposit, 2 - stake deposit, 3 - worker payout, 4 - DAO fee, 5 - refund, 6 - forfeited stake, 7 - stake return)"	collectionFormat(csv)
+//	@Param		page				query		int			false	"Page number"																																						default(1)
+//	@Param		page_size			query		string		false	"Number of items per page"																																			default(10)
+//	@Param		order_direction		query		string		false	"Sorting direction"																																					Enums(ASC, DESC)		default(ASC)
+//	@Param		order_by			query		[]string	false	"Comma-separated list of sorting fields (timestamp,amount)"																											collectionFormat(csv)	default(timestamp)
+//
+//	@Success	200					{object}	http_response.PaginatedResponse{data=entities.CSPRFlow}
+//	@Failure	400,404,500			{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		BidEscrow
+func (h *JobOffer) HandleGetJobPayments(w http.ResponseWriter, r *http.Request) {
+	jobID, err := http_params.ParseUint32("job_id", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	csprFlowTypeIDs, err := parseCSPRFlowTypeIDs(r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("timestamp", pagination.OrderDirectionASC)
+
+	getJobPayments := jobs.NewGetJobPayments()
+	getJobPayments.SetEntityManager(h.entityManager)
+	getJobPayments.SetPaginationParams(paginationParams)
+	getJobPayments.SetJobID(jobID)
+	getJobPayments.SetCSPRFlowTypeIDs(csprFlowTypeIDs)
+
+	http_response.FromFunction(getJobPayments.Execute, w, r)
+}
+
+// HandleGetAccountPayments
+//
+//	@Summary	Return paginated list of BidEscrow CSPR flows sent or received by account
+//
+//	@Router		/accounts/{address}/payments [GET]
+//
+//	@Param		address				path		string		true	"Hash or PublicKey"																																					maxlength(66)
+//	@Param		cspr_flow_type_id	query		[]int		false	"Comma-separated list of flow type ids (1 - payment deposit, 2 - stake deposit, 3 - worker payout, 4 - DAO fee, 5 - refund, 6 - forfeited stake, 7 - stake return)"	collectionFormat(csv)
+//	@Param		from				query		string		false	"Lower bound of flow timestamp (RFC3339)"
+//	@Param		to					query		string		false	"Upper bound of flow timestamp (RFC3339)"
+//	@Param		page				query		int			false	"Page number"														default(1)
+//	@Param		page_size			query		string		false	"Number of items per page"											default(10)
+//	@Param		order_direction		query		string		false	"Sorting direction"													Enums(ASC, DESC)		default(DESC)
+//	@Param		order_by			query		[]string	false	"Comma-separated list of sorting fields (timestamp,amount,job_id)"	collectionFormat(csv)	default(timestamp)
+//
+//	@Success	200					{object}	http_response.PaginatedResponse{data=entities.CSPRFlow}
+//	@Failure	400,404,500			{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		BidEscrow
+func (h *JobOffer) HandleGetAccountPayments(w http.ResponseWriter, r *http.Request) {
+	addressHash, err := http_params.ParseOptionalHash("address", r)
+	if err != nil {
+		accountPubKey, err := http_params.ParseOptionalPublicKey("address", r)
+		if err != nil {
+			http_response.Error(w, r, errors.NewInvalidInputError("Account address is not a valid account hash or public key"))
+			return
+		}
+		accountHash := accountPubKey.AccountHash()
+		addressHash = &accountHash.Hash
+	}
+
+	csprFlowTypeIDs, err := parseCSPRFlowTypeIDs(r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	from, err := http_params.ParseOptionalTime("from", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	to, err := http_params.ParseOptionalTime("to", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("timestamp", pagination.OrderDirectionDESC)
+
+	getAccountPayments := jobs.NewGetAccountPayments()
+	getAccountPayments.SetEntityManager(h.entityManager)
+	getAccountPayments.SetPaginationParams(paginationParams)
+	getAccountPayments.SetAddress(*addressHash)
+	getAccountPayments.SetCSPRFlowTypeIDs(csprFlowTypeIDs)
+	getAccountPayments.SetFrom(from)
+	getAccountPayments.SetTo(to)
+
+	http_response.FromFunction(getAccountPayments.Execute, w, r)
+}
+
+func parseCSPRFlowTypeIDs(r *http.Request) ([]entities.CSPRFlowTypeID, error) {
+	rawCSPRFlowTypeIDs, err := http_params.ParseOptionalUint16List("cspr_flow_type_id", r)
+	if err != nil {
+		return nil, err
+	}
+
+	csprFlowTypeIDs := make([]entities.CSPRFlowTypeID, 0, len(rawCSPRFlowTypeIDs))
+	for _, csprFlowTypeID := range rawCSPRFlowTypeIDs {
+		csprFlowTypeIDs = append(csprFlowTypeIDs, entities.CSPRFlowTypeID(csprFlowTypeID))
+	}
+
+	return csprFlowTypeIDs, nil
+}
+
 // HandleGetJobStatuses
 //
 //	@Summary	Return predefined list of JobStatuses
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"net/http"
+
+	"casper-dao-middleware/apps/api/serialization"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/persistence"
+	"casper-dao-middleware/internal/dao/services/onboarding"
+	"casper-dao-middleware/pkg/errors"
+	http_params "casper-dao-middleware/pkg/http-params"
+	http_response "casper-dao-middleware/pkg/http-response"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/serialize"
+)
+
+type OnboardingRequest struct {
+	entityManager persistence.EntityManager
+}
+
+func NewOnboardingRequest(entityManager persistence.EntityManager) *OnboardingRequest {
+	return &OnboardingRequest{
+		entityManager: entityManager,
+	}
+}
+
+// HandleGetOnboardingRequests
+//
+//	@Summary	Return paginated list of onboarding requests
+//
+//	@Router		/onboarding-requests [GET]
+//
+//	@Param		requester						query		string		false	"Hash or PublicKey of requester"																maxlength(66)
+//	@Param		onboarding_request_status_id	query		[]int		false	"Comma-separated list of status ids (1 - pending, 2 - accepted, 3 - rejected, 4 - canceled)"	collectionFormat(csv)
+//	@Param		includes						query		string		false	"Optional fields' schema (voting{})"
+//	@Param		page							query		int			false	"Page number"																default(1)
+//	@Param		page_size						query		string		false	"Number of items per page"													default(10)
+//	@Param		order_direction					query		string		false	"Sorting direction"															Enums(ASC, DESC)		default(DESC)
+//	@Param		order_by						query		[]string	false	"Comma-separated list of sorting fields (voting_id,cspr_deposit,timestamp)"	collectionFormat(csv)	default(voting_id)
+//
+//	@Success	200								{object}	http_response.PaginatedResponse{data=entities.OnboardingRequest}
+//	@Failure	400,404,500						{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Onboarding
+func (h *OnboardingRequest) HandleGetOnboardingRequests(w http.ResponseWriter, r *http.Request) {
+	requester, err := http_params.ParseOptionalHash("requester", r)
+	if err != nil {
+		requesterPubKey, err := http_params.ParseOptionalPublicKey("requester", r)
+		if err != nil {
+			http_response.Error(w, r, errors.NewInvalidInputError("Requester is not a valid account hash or public key"))
+			return
+		}
+		requesterHash := requesterPubKey.AccountHash()
+		requester = &requesterHash.Hash
+	}
+
+	rawStatusIDs, err := http_params.ParseOptionalUint16List("onboarding_request_status_id", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	statusIDs := make([]entities.OnboardingRequestStatusID, 0, len(rawStatusIDs))
+	for _, statusID := range rawStatusIDs {
+		statusIDs = append(statusIDs, entities.OnboardingRequestStatusID(statusID))
+	}
+
+	includes, err := http_params.ParseOptionalData("includes", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("voting_id", pagination.OrderDirectionDESC)
+
+	getOnboardingRequests := onboarding.NewGetOnboardingRequests()
+	getOnboardingRequests.SetEntityManager(h.entityManager)
+	getOnboardingRequests.SetPaginationParams(paginationParams)
+	getOnboardingRequests.SetRequester(requester)
+	getOnboardingRequests.SetOnboardingRequestStatusIDs(statusIDs)
+
+	paginatedRequests, err := getOnboardingRequests.Execute()
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	requestsJSON := serialize.ToRawJSONList(paginatedRequests.Data)
+
+	if optionalVotingData, ok := includes.Contains("voting"); ok {
+		votingIncluder := serialization.NewVotingIncluder(requestsJSON, h.entityManager)
+		votingIncluder.Include(optionalVotingData, "voting_id")
+	}
+
+	paginatedRequests.Data = requestsJSON
+	http_response.WriteJSON(w, http.StatusOK, paginatedRequests)
+}
+
+// HandleGetOnboardingRequestByID
+//
+//	@Summary	Return onboarding request by its voting id
+//
+//	@Router		/onboarding-requests/{voting_id} [GET]
+//
+//	@Param		voting_id	path		uint	true	"VotingID uint"
+//	@Param		includes	query		string	false	"Optional fields' schema (voting{})"
+//
+//	@Success	200			{object}	http_response.SuccessResponse{data=entities.OnboardingRequest}
+//	@Failure	400,404,500	{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Onboarding
+func (h *OnboardingRequest) HandleGetOnboardingRequestByID(w http.ResponseWriter, r *http.Request) {
+	votingID, err := http_params.ParseUint32("voting_id", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	includes, err := http_params.ParseOptionalData("includes", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	getOnboardingRequest := onboarding.NewGetOnboardingRequestByID()
+	getOnboardingRequest.SetEntityManager(h.entityManager)
+	getOnboardingRequest.SetVotingID(votingID)
+
+	request, err := getOnboardingRequest.Execute()
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	requestsJSON := []map[string]interface{}{serialize.ToRawJSON(request)}
+
+	if optionalVotingData, ok := includes.Contains("voting"); ok {
+		votingIncluder := serialization.NewVotingIncluder(requestsJSON, h.entityManager)
+		votingIncluder.Include(optionalVotingData, "voting_id")
+	}
+
+	http_response.Success(w, requestsJSON[0])
+}
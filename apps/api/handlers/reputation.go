@@ -3,6 +3,10 @@ package handlers
 import (
 	"net/http"
 
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/apps/api/serialization"
+	"casper-dao-middleware/internal/dao/entities"
 	"casper-dao-middleware/internal/dao/persistence"
 	"casper-dao-middleware/internal/dao/services/reputation"
 	"casper-dao-middleware/internal/dao/utils"
@@ -10,6 +14,7 @@ import (
 	http_params "casper-dao-middleware/pkg/http-params"
 	"casper-dao-middleware/pkg/http-response"
 	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/serialize"
 )
 
 type Reputation struct {
@@ -58,3 +63,203 @@ func (h *Reputation) HandleGetTotalReputationSnapshots(w http.ResponseWriter, r
 
 	http_response.FromFunction(getTotalReputation.Execute, w, r)
 }
+
+// HandleGetAccountReputation
+//
+//	@Summary	Return liquid and staked reputation of the account as of the provided moment
+//
+//	@Router		/accounts/{address}/reputation [GET]
+//
+//	@Param		address		path		string	true	"Hash or PublicKey"	maxlength(66)
+//	@Param		as_of		query		string	false	"Timestamp (RFC3339) or hash of the deploy which changed the reputation, current balance by default"
+//
+//	@Success	200			{object}	http_response.SuccessResponse{data=entities.AccountReputation}
+//	@Failure	400,404,500	{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Reputation
+func (h *Reputation) HandleGetAccountReputation(w http.ResponseWriter, r *http.Request) {
+	addressHash, err := http_params.ParseOptionalHash("address", r)
+	if err != nil {
+		accountPubKey, err := http_params.ParseOptionalPublicKey("address", r)
+		if err != nil {
+			http_response.Error(w, r, errors.NewInvalidInputError("Account address is not a valid account hash or public key"))
+			return
+		}
+		accountHash := accountPubKey.AccountHash()
+		addressHash = &accountHash.Hash
+	}
+
+	asOfTime, err := http_params.ParseOptionalTime("as_of", r)
+	var asOfDeployHash *casper.Hash
+	if err != nil {
+		asOfDeployHash, err = http_params.ParseOptionalHash("as_of", r)
+		if err != nil {
+			http_response.Error(w, r, errors.NewInvalidInputError("as_of is not a valid timestamp or deploy hash"))
+			return
+		}
+	}
+
+	getAccountReputation := reputation.NewGetAccountReputation()
+	getAccountReputation.SetEntityManager(h.entityManager)
+	getAccountReputation.SetDAOContractsMetadata(h.daoContractPackageHashes)
+	getAccountReputation.SetAddress(*addressHash)
+	getAccountReputation.SetAsOfTime(asOfTime)
+	getAccountReputation.SetAsOfDeployHash(asOfDeployHash)
+
+	http_response.FromFunction(getAccountReputation.Execute, w, r)
+}
+
+// HandleGetAccountReputationChanges
+//
+//	@Summary	Return paginated list of reputation changes for account
+//
+//	@Router		/accounts/{address}/reputation-changes [GET]
+//
+//	@Param		address					path		string		true	"Hash or PublicKey"																							maxlength(66)
+//	@Param		reason					query		[]int		false	"Comma-separated list of reason ids (1-Minted,2-Burned,3-Staked,4-VotingGained,5-VotingLost,6-Unstaked)"	collectionFormat(csv)
+//	@Param		contract_package_hash	query		[]string	false	"Comma-separated list of contract package hashes"															collectionFormat(csv)
+//	@Param		voting_id				query		[]int		false	"Comma-separated list of VotingIDs"																			collectionFormat(csv)
+//	@Param		from					query		string		false	"Changes made after the time (RFC3339)"
+//	@Param		to						query		string		false	"Changes made before the time (RFC3339)"
+//	@Param		includes				query		string		false	"Optional fields' schema (voting{})"
+//	@Param		page					query		int			false	"Page number"															default(1)
+//	@Param		page_size				query		string		false	"Number of items per page"												default(10)
+//	@Param		order_direction			query		string		false	"Sorting direction"														Enums(ASC, DESC)		default(DESC)
+//	@Param		order_by				query		[]string	false	"Comma-separated list of sorting fields (timestamp,amount,voting_id)"	collectionFormat(csv)	default(timestamp)
+//
+//	@Success	200						{object}	http_response.PaginatedResponse{data=entities.ReputationChange}
+//	@Failure	400,404,500				{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Reputation
+func (h *Reputation) HandleGetAccountReputationChanges(w http.ResponseWriter, r *http.Request) {
+	addressHash, err := http_params.ParseOptionalHash("address", r)
+	if err != nil {
+		accountPubKey, err := http_params.ParseOptionalPublicKey("address", r)
+		if err != nil {
+			http_response.Error(w, r, errors.NewInvalidInputError("Account address is not a valid account hash or public key"))
+			return
+		}
+		accountHash := accountPubKey.AccountHash()
+		addressHash = &accountHash.Hash
+	}
+
+	reasonIDs, err := http_params.ParseOptionalUint16List("reason", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	reasons := make([]entities.ReputationChangeReason, 0, len(reasonIDs))
+	for _, reasonID := range reasonIDs {
+		reasons = append(reasons, entities.ReputationChangeReason(reasonID))
+	}
+
+	contractPackageHashes, err := http_params.ParseOptionalHashList("contract_package_hash", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	votingIDs, err := http_params.ParseOptionalUint32List("voting_id", r)
+	if err != nil {
+		http_response.Error(w, r, errors.NewInvalidInputError("Invalid `voting_id` format"))
+		return
+	}
+
+	from, err := http_params.ParseOptionalTime("from", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	to, err := http_params.ParseOptionalTime("to", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	includes, err := http_params.ParseOptionalData("includes", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("timestamp", pagination.OrderDirectionDESC)
+
+	getReputationChanges := reputation.NewGetReputationChanges()
+	getReputationChanges.SetEntityManager(h.entityManager)
+	getReputationChanges.SetPaginationParams(paginationParams)
+	getReputationChanges.SetAddress(addressHash)
+	getReputationChanges.SetReasons(reasons)
+	getReputationChanges.SetContractPackageHashes(contractPackageHashes)
+	getReputationChanges.SetVotingIDs(votingIDs)
+	getReputationChanges.SetFrom(from)
+	getReputationChanges.SetTo(to)
+
+	paginatedChanges, err := getReputationChanges.Execute()
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	changesJSON := serialize.ToRawJSONList(paginatedChanges.Data)
+
+	if optionalVotingData, ok := includes.Contains("voting"); ok {
+		votingIncluder := serialization.NewVotingIncluder(changesJSON, h.entityManager)
+		votingIncluder.Include(optionalVotingData, "voting_id")
+	}
+
+	paginatedChanges.Data = changesJSON
+	http_response.WriteJSON(w, http.StatusOK, paginatedChanges)
+}
+
+// HandleGetReputationLeaderboard
+//
+//	@Summary	Return paginated list of accounts ordered by aggregated reputation
+//
+//	@Router		/reputation/leaderboard [GET]
+//
+//	@Param		is_va			query		bool		false	"Is VA/non VA accounts filtering"
+//	@Param		from			query		string		false	"Aggregate only reputation changes made after the time (RFC3339)"
+//	@Param		to				query		string		false	"Aggregate only reputation changes made before the time (RFC3339)"
+//	@Param		page			query		int			false	"Page number"																					default(1)
+//	@Param		page_size		query		string		false	"Number of items per page"																		default(10)
+//	@Param		order_direction	query		string		false	"Sorting direction"																				Enums(ASC, DESC)		default(DESC)
+//	@Param		order_by		query		[]string	false	"Comma-separated list of sorting fields (total_reputation,liquid_reputation,staked_reputation)"	collectionFormat(csv)	default(total_reputation)
+//
+//	@Success	200				{object}	http_response.PaginatedResponse{data=entities.AggregatedReputationBalance}
+//	@Failure	400,404,500		{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Reputation
+func (h *Reputation) HandleGetReputationLeaderboard(w http.ResponseWriter, r *http.Request) {
+	isVA, err := http_params.ParseOptionalBool("is_va", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	from, err := http_params.ParseOptionalTime("from", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	to, err := http_params.ParseOptionalTime("to", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("total_reputation", pagination.OrderDirectionDESC)
+
+	getLeaderboard := reputation.NewGetReputationLeaderboard()
+	getLeaderboard.SetEntityManager(h.entityManager)
+	getLeaderboard.SetPaginationParams(paginationParams)
+	getLeaderboard.SetIsVA(isVA)
+	getLeaderboard.SetFrom(from)
+	getLeaderboard.SetTo(to)
+
+	http_response.FromFunction(getLeaderboard.Execute, w, r)
+}
@@ -3,10 +3,14 @@ package handlers
 import (
 	"net/http"
 
+	"casper-dao-middleware/apps/api/serialization"
+	"casper-dao-middleware/internal/dao/entities"
 	"casper-dao-middleware/internal/dao/persistence"
 	"casper-dao-middleware/internal/dao/services/settings"
+	http_params "casper-dao-middleware/pkg/http-params"
 	http_response "casper-dao-middleware/pkg/http-response"
 	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/serialize"
 )
 
 type Setting struct {
@@ -21,7 +25,7 @@ func NewSetting(entityManager persistence.EntityManager) *Setting {
 
 // HandleGetSettings
 //
-//	@Summary	Return paginated list of settings
+//	@Summary	Return paginated list of settings with values decoded by their type and the setting definition
 //
 //	@Router		/settings [GET]
 //
@@ -43,3 +47,139 @@ func (h *Setting) HandleGetSettings(w http.ResponseWriter, r *http.Request) {
 
 	http_response.FromFunction(getSettings.Execute, w, r)
 }
+
+// HandleGetSettingByName
+//
+//	@Summary	Return setting by name with its pending value, or the value effective at the provided time
+//
+//	@Router		/settings/{name} [GET]
+//
+//	@Param		name			path		string	true	"Setting name"
+//	@Param		effective_at	query		string	false	"Time to return the value effective at (RFC3339)"
+//
+//	@Success	200				{object}	http_response.SuccessResponse{data=entities.Setting}
+//	@Failure	400,404,500		{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Setting
+func (h *Setting) HandleGetSettingByName(w http.ResponseWriter, r *http.Request) {
+	name, err := http_params.ParseString("name", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	effectiveAt, err := http_params.ParseOptionalTime("effective_at", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	getSettingByName := settings.NewGetSettingByName()
+	getSettingByName.SetEntityManager(h.entityManager)
+	getSettingByName.SetName(name)
+	getSettingByName.SetEffectiveAt(effectiveAt)
+
+	http_response.FromFunction(getSettingByName.Execute, w, r)
+}
+
+// HandleGetSettingHistory
+//
+//	@Summary	Return paginated list of setting value changes
+//
+//	@Router		/settings/{name}/history [GET]
+//
+//	@Param		name			path		string		true	"Setting name"
+//	@Param		page			query		int			false	"Page number"														default(1)
+//	@Param		page_size		query		string		false	"Number of items per page"											default(10)
+//	@Param		order_direction	query		string		false	"Sorting direction"													Enums(ASC, DESC)		default(DESC)
+//	@Param		order_by		query		[]string	false	"Comma-separated list of sorting fields (timestamp,effective_at)"	collectionFormat(csv)	default(timestamp)
+//
+//	@Success	200				{object}	http_response.PaginatedResponse{data=entities.SettingChange}
+//	@Failure	400,404,500		{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Setting
+func (h *Setting) HandleGetSettingHistory(w http.ResponseWriter, r *http.Request) {
+	name, err := http_params.ParseString("name", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("timestamp", pagination.OrderDirectionDESC)
+
+	getSettingHistory := settings.NewGetSettingHistory()
+	getSettingHistory.SetEntityManager(h.entityManager)
+	getSettingHistory.SetPaginationParams(paginationParams)
+	getSettingHistory.SetName(name)
+
+	http_response.FromFunction(getSettingHistory.Execute, w, r)
+}
+
+// HandleGetSettingProposals
+//
+//	@Summary	Return paginated list of setting changes proposed by Repo Voter votings
+//
+//	@Router		/settings/proposals [GET]
+//
+//	@Param		name						query		string		false	"Setting name"
+//	@Param		setting_proposal_status_id	query		[]int		false	"Comma-separated list of status ids (1 - pending, 2 - accepted, 3 - rejected, 4 - canceled)"	collectionFormat(csv)
+//	@Param		includes					query		string		false	"Optional fields' schema (voting{})"
+//	@Param		page						query		int			false	"Page number"																	default(1)
+//	@Param		page_size					query		string		false	"Number of items per page"														default(10)
+//	@Param		order_direction				query		string		false	"Sorting direction"																Enums(ASC, DESC)		default(DESC)
+//	@Param		order_by					query		[]string	false	"Comma-separated list of sorting fields (voting_id,activation_time,timestamp)"	collectionFormat(csv)	default(voting_id)
+//
+//	@Success	200							{object}	http_response.PaginatedResponse{data=entities.SettingProposal}
+//	@Failure	400,404,500					{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Setting
+func (h *Setting) HandleGetSettingProposals(w http.ResponseWriter, r *http.Request) {
+	name, err := http_params.ParseOptionalString("name", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	rawStatusIDs, err := http_params.ParseOptionalUint16List("setting_proposal_status_id", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	statusIDs := make([]entities.SettingProposalStatusID, 0, len(rawStatusIDs))
+	for _, statusID := range rawStatusIDs {
+		statusIDs = append(statusIDs, entities.SettingProposalStatusID(statusID))
+	}
+
+	includes, err := http_params.ParseOptionalData("includes", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("voting_id", pagination.OrderDirectionDESC)
+
+	getSettingProposals := settings.NewGetSettingProposals()
+	getSettingProposals.SetEntityManager(h.entityManager)
+	getSettingProposals.SetPaginationParams(paginationParams)
+	getSettingProposals.SetName(name)
+	getSettingProposals.SetSettingProposalStatusIDs(statusIDs)
+
+	paginatedProposals, err := getSettingProposals.Execute()
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	proposalsJSON := serialize.ToRawJSONList(paginatedProposals.Data)
+
+	if optionalVotingData, ok := includes.Contains("voting"); ok {
+		votingIncluder := serialization.NewVotingIncluder(proposalsJSON, h.entityManager)
+		votingIncluder.Include(optionalVotingData, "voting_id")
+	}
+
+	paginatedProposals.Data = proposalsJSON
+	http_response.WriteJSON(w, http.StatusOK, paginatedProposals)
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/apps/api/serialization"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/persistence"
+	"casper-dao-middleware/internal/dao/services/slashing"
+	"casper-dao-middleware/pkg/errors"
+	http_params "casper-dao-middleware/pkg/http-params"
+	http_response "casper-dao-middleware/pkg/http-response"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/serialize"
+)
+
+type Slashing struct {
+	entityManager persistence.EntityManager
+}
+
+func NewSlashing(entityManager persistence.EntityManager) *Slashing {
+	return &Slashing{
+		entityManager: entityManager,
+	}
+}
+
+// HandleGetSlashings
+//
+//	@Summary	Return paginated list of slashings
+//
+//	@Router		/slashings [GET]
+//
+//	@Param		slashing_status_id	query		[]int		false	"Comma-separated list of status ids (1 - pending, 2 - slashed, 3 - rejected, 4 - canceled)"	collectionFormat(csv)
+//	@Param		includes			query		string		false	"Optional fields' schema (voting{})"
+//	@Param		page				query		int			false	"Page number"																					default(1)
+//	@Param		page_size			query		string		false	"Number of items per page"																		default(10)
+//	@Param		order_direction		query		string		false	"Sorting direction"																				Enums(ASC, DESC)		default(DESC)
+//	@Param		order_by			query		[]string	false	"Comma-separated list of sorting fields (voting_id,slash_ratio,reputation_burned,timestamp)"	collectionFormat(csv)	default(voting_id)
+//
+//	@Success	200					{object}	http_response.PaginatedResponse{data=entities.Slashing}
+//	@Failure	400,404,500			{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Slashing
+func (h *Slashing) HandleGetSlashings(w http.ResponseWriter, r *http.Request) {
+	h.handleGetSlashings(w, r, nil)
+}
+
+// HandleGetAccountSlashings
+//
+//	@Summary	Return paginated list of slashings started against the account
+//
+//	@Router		/accounts/{address}/slashings [GET]
+//
+//	@Param		address				path		string		true	"Hash or PublicKey"																			maxlength(66)
+//	@Param		slashing_status_id	query		[]int		false	"Comma-separated list of status ids (1 - pending, 2 - slashed, 3 - rejected, 4 - canceled)"	collectionFormat(csv)
+//	@Param		includes			query		string		false	"Optional fields' schema (voting{})"
+//	@Param		page				query		int			false	"Page number"																					default(1)
+//	@Param		page_size			query		string		false	"Number of items per page"																		default(10)
+//	@Param		order_direction		query		string		false	"Sorting direction"																				Enums(ASC, DESC)		default(DESC)
+//	@Param		order_by			query		[]string	false	"Comma-separated list of sorting fields (voting_id,slash_ratio,reputation_burned,timestamp)"	collectionFormat(csv)	default(voting_id)
+//
+//	@Success	200					{object}	http_response.PaginatedResponse{data=entities.Slashing}
+//	@Failure	400,404,500			{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Slashing
+func (h *Slashing) HandleGetAccountSlashings(w http.ResponseWriter, r *http.Request) {
+	addressHash, err := http_params.ParseOptionalHash("address", r)
+	if err != nil {
+		accountPubKey, err := http_params.ParseOptionalPublicKey("address", r)
+		if err != nil {
+			http_response.Error(w, r, errors.NewInvalidInputError("Account address is not a valid account hash or public key"))
+			return
+		}
+		accountHash := accountPubKey.AccountHash()
+		addressHash = &accountHash.Hash
+	}
+
+	h.handleGetSlashings(w, r, addressHash)
+}
+
+func (h *Slashing) handleGetSlashings(w http.ResponseWriter, r *http.Request, addressToSlash *casper.Hash) {
+	rawStatusIDs, err := http_params.ParseOptionalUint16List("slashing_status_id", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	statusIDs := make([]entities.SlashingStatusID, 0, len(rawStatusIDs))
+	for _, statusID := range rawStatusIDs {
+		statusIDs = append(statusIDs, entities.SlashingStatusID(statusID))
+	}
+
+	includes, err := http_params.ParseOptionalData("includes", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("voting_id", pagination.OrderDirectionDESC)
+
+	getSlashings := slashing.NewGetSlashings()
+	getSlashings.SetEntityManager(h.entityManager)
+	getSlashings.SetPaginationParams(paginationParams)
+	getSlashings.SetAddressToSlash(addressToSlash)
+	getSlashings.SetSlashingStatusIDs(statusIDs)
+
+	paginatedSlashings, err := getSlashings.Execute()
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	slashingsJSON := serialize.ToRawJSONList(paginatedSlashings.Data)
+
+	if optionalVotingData, ok := includes.Contains("voting"); ok {
+		votingIncluder := serialization.NewVotingIncluder(slashingsJSON, h.entityManager)
+		votingIncluder.Include(optionalVotingData, "voting_id")
+	}
+
+	paginatedSlashings.Data = slashingsJSON
+	http_response.WriteJSON(w, http.StatusOK, paginatedSlashings)
+}
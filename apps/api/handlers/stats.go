@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"net/http"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/persistence"
+	"casper-dao-middleware/internal/dao/services/stats"
+	"casper-dao-middleware/pkg/errors"
+	http_params "casper-dao-middleware/pkg/http-params"
+	http_response "casper-dao-middleware/pkg/http-response"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type Stats struct {
+	entityManager persistence.EntityManager
+}
+
+func NewStats(entityManager persistence.EntityManager) *Stats {
+	return &Stats{
+		entityManager: entityManager,
+	}
+}
+
+// HandleGetTimeSeries
+//
+//	@Summary	Return DAO-wide metric aggregated per day, week or month, periods without values are omitted
+//
+//	@Router		/stats/timeseries [GET]
+//
+//	@Param		metric		query		string	true	"Metric name"									Enums(votings_created, ballots_cast, unique_voters, reputation_minted, reputation_burned, new_vas, new_kyc_accounts, job_offers_posted, jobs_completed)
+//	@Param		interval	query		string	false	"Aggregation interval, weeks start on Monday"	Enums(day, week, month)	default(day)
+//	@Param		from		query		string	false	"Inclusive period start in RFC3339 format"
+//	@Param		to			query		string	false	"Exclusive period end in RFC3339 format"
+//
+//	@Success	200			{object}	http_response.SuccessResponse{data=[]entities.TimeSeriesPoint}
+//	@Failure	400,404,500	{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Stats
+func (h *Stats) HandleGetTimeSeries(w http.ResponseWriter, r *http.Request) {
+	rawMetric, err := http_params.ParseOptionalString("metric", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	if rawMetric == nil || !entities.TimeSeriesMetric(*rawMetric).IsValid() {
+		http_response.Error(w, r, errors.NewInvalidInputError("Invalid `metric` value, expected one of the supported metrics"))
+		return
+	}
+
+	rawInterval, err := http_params.ParseOptionalString("interval", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	interval := entities.TimeSeriesIntervalDay
+	if rawInterval != nil {
+		interval = entities.TimeSeriesInterval(*rawInterval)
+		if !interval.IsValid() {
+			http_response.Error(w, r, errors.NewInvalidInputError("Invalid `interval` value, expected day, week or month"))
+			return
+		}
+	}
+
+	from, err := http_params.ParseOptionalTime("from", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	to, err := http_params.ParseOptionalTime("to", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	if from != nil && to != nil && !from.Before(*to) {
+		http_response.Error(w, r, errors.NewInvalidInputError("Invalid period, `from` should be before `to`"))
+		return
+	}
+
+	getTimeSeries := stats.NewGetTimeSeries()
+	getTimeSeries.SetEntityManager(h.entityManager)
+	getTimeSeries.SetMetric(entities.TimeSeriesMetric(*rawMetric))
+	getTimeSeries.SetInterval(interval)
+	getTimeSeries.SetFrom(from)
+	getTimeSeries.SetTo(to)
+
+	http_response.FromFunction(getTimeSeries.Execute, w, r)
+}
+
+// HandleGetVotingTypeParticipation
+//
+//	@Summary	Return participation of not canceled votings aggregated by voting type, averages are calculated over the ended voting stages
+//
+//	@Router		/stats/participation [GET]
+//
+//	@Success	200			{object}	http_response.SuccessResponse{data=[]entities.VotingTypeParticipation}
+//	@Failure	400,404,500	{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Stats
+func (h *Stats) HandleGetVotingTypeParticipation(w http.ResponseWriter, r *http.Request) {
+	getVotingTypeParticipation := stats.NewGetVotingTypeParticipation()
+	getVotingTypeParticipation.SetEntityManager(h.entityManager)
+
+	http_response.FromFunction(getVotingTypeParticipation.Execute, w, r)
+}
+
+// HandleGetVotingParticipation
+//
+//	@Summary	Return paginated list of per voting turnout, staked reputation share and informal to formal vote switches
+//
+//	@Router		/stats/participation/votings [GET]
+//
+//	@Param		voting_type_id	query		[]int		false	"Comma-separated list of voting type ids"								collectionFormat(csv)
+//	@Param		page			query		int			false	"Page number"															default(1)
+//	@Param		page_size		query		string		false	"Number of items per page"												default(10)
+//	@Param		order_direction	query		string		false	"Sorting direction"														Enums(ASC, DESC)		default(DESC)
+//	@Param		order_by		query		[]string	false	"Comma-separated list of sorting fields (voting_id, voting_type_id)"	collectionFormat(csv)	default(voting_id)
+//
+//	@Success	200				{object}	http_response.PaginatedResponse{data=entities.VotingParticipation}
+//	@Failure	400,404,500		{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Stats
+func (h *Stats) HandleGetVotingParticipation(w http.ResponseWriter, r *http.Request) {
+	rawVotingTypeIDs, err := http_params.ParseOptionalUint16List("voting_type_id", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	votingTypeIDs := make([]entities.VotingTypeID, 0, len(rawVotingTypeIDs))
+	for _, votingTypeID := range rawVotingTypeIDs {
+		votingTypeIDs = append(votingTypeIDs, entities.VotingTypeID(votingTypeID))
+	}
+
+	paginationParams := pagination.NewParamsFromRequest(r)
+	paginationParams.SetDefaultOrder("voting_id", pagination.OrderDirectionDESC)
+
+	getVotingParticipation := stats.NewGetVotingParticipation()
+	getVotingParticipation.SetEntityManager(h.entityManager)
+	getVotingParticipation.SetPaginationParams(paginationParams)
+	getVotingParticipation.SetVotingTypeIDs(votingTypeIDs)
+
+	http_response.FromFunction(getVotingParticipation.Execute, w, r)
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"casper-dao-middleware/apps/api/serialization"
+	"casper-dao-middleware/internal/dao/entities"
 	"casper-dao-middleware/internal/dao/persistence"
 	"casper-dao-middleware/internal/dao/services/votes"
 	"casper-dao-middleware/internal/dao/services/voting"
@@ -31,6 +32,7 @@ func NewVoting(entityManager persistence.EntityManager) *Voting {
 //
 //	@Param		voting_id		path		string		false	"Comma-separated list of VotingIDs (number)"
 //	@Param		is_formal		query		bool		false	"Is formal/informal filtering"
+//	@Param		stake_type_id	query		[]int		false	"Comma-separated list of stake type ids (1 - reputation, 2 - CSPR)"	collectionFormat(csv)
 //	@Param		includes		query		string		false	"Optional fields' schema (voting{})"
 //	@Param		page			query		int			false	"Page number"													default(1)
 //	@Param		page_size		query		string		false	"Number of items per page"										default(10)
@@ -54,6 +56,12 @@ func (h *Voting) HandleGetVotingVotes(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	stakeTypeIDs, err := parseStakeTypeIDs(r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
 	includes, err := http_params.ParseOptionalData("includes", r)
 	if err != nil {
 		http_response.Error(w, r, err)
@@ -67,6 +75,7 @@ func (h *Voting) HandleGetVotingVotes(w http.ResponseWriter, r *http.Request) {
 	getVotes.SetEntityManager(h.entityManager)
 	getVotes.SetPaginationParams(paginationParams)
 	getVotes.SetIsFormal(isFormal)
+	getVotes.SetStakeTypeIDs(stakeTypeIDs)
 
 	paginatedVotes, err := getVotes.Execute()
 	if err != nil {
@@ -91,7 +100,7 @@ func (h *Voting) HandleGetVotingVotes(w http.ResponseWriter, r *http.Request) {
 //
 //	@Router		/votings [GET]
 //
-//	@Param		includes		query		string		false	"Optional fields' schema (votes_number{}, account_vote(hash))"
+//	@Param		includes		query		string		false	"Optional fields' schema (votes_number{}, stake_tallies{}, executed_effects{}, config{}, account_vote(hash))"
 //	@Param		page			query		int			false	"Page number"											default(1)
 //	@Param		page_size		query		string		false	"Number of items per page"								default(10)
 //	@Param		order_direction	query		string		false	"Sorting direction"										Enums(ASC, DESC)		default(ASC)
@@ -126,6 +135,21 @@ func (h *Voting) HandleGetVotings(w http.ResponseWriter, r *http.Request) {
 		votesNumberIncluder.Include("voting_id")
 	}
 
+	if _, ok := includes.Contains("stake_tallies"); ok {
+		stakeTalliesIncluder := serialization.NewStakeTalliesIncluder(votingsJSON, h.entityManager)
+		stakeTalliesIncluder.Include("voting_id")
+	}
+
+	if _, ok := includes.Contains("executed_effects"); ok {
+		votingEffectsIncluder := serialization.NewVotingEffectsIncluder(votingsJSON, h.entityManager)
+		votingEffectsIncluder.Include("voting_id")
+	}
+
+	if _, ok := includes.Contains("config"); ok {
+		votingConfigIncluder := serialization.NewVotingConfigIncluder(votingsJSON, h.entityManager)
+		votingConfigIncluder.Include("voting_id")
+	}
+
 	if arg, ok := includes.ContainsFunc("account_vote"); ok {
 		voteIncluder := serialization.NewAccountVoteIncluder(votingsJSON, h.entityManager)
 		voteIncluder.Include(arg, "voting_id")
@@ -134,3 +158,113 @@ func (h *Voting) HandleGetVotings(w http.ResponseWriter, r *http.Request) {
 	paginatedVotings.Data = votingsJSON
 	http_response.WriteJSON(w, http.StatusOK, paginatedVotings)
 }
+
+// HandleGetVotingByID
+//
+//	@Summary	Return voting by id with the on-chain effects executed on its end
+//
+//	@Router		/votings/{voting_id} [GET]
+//
+//	@Param		voting_id	path		uint	true	"VotingID uint"
+//	@Param		includes	query		string	false	"Optional fields' schema (votes_number{}, stake_tallies{}, config{}, account_vote(hash))"
+//
+//	@Success	200			{object}	http_response.SuccessResponse{data=entities.Voting}
+//	@Failure	400,404,500	{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Voting
+func (h *Voting) HandleGetVotingByID(w http.ResponseWriter, r *http.Request) {
+	votingID, err := http_params.ParseUint32("voting_id", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	includes, err := http_params.ParseOptionalData("includes", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	getVotingByID := voting.NewGetVotingByID()
+	getVotingByID.SetEntityManager(h.entityManager)
+	getVotingByID.SetVotingID(votingID)
+
+	votingEntity, err := getVotingByID.Execute()
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	votingsJSON := []map[string]interface{}{serialize.ToRawJSON(votingEntity)}
+
+	votingEffectsIncluder := serialization.NewVotingEffectsIncluder(votingsJSON, h.entityManager)
+	votingEffectsIncluder.Include("voting_id")
+
+	if _, ok := includes.Contains("votes_number"); ok {
+		votesNumberIncluder := serialization.NewVotesNumberIncluder(votingsJSON, h.entityManager)
+		votesNumberIncluder.Include("voting_id")
+	}
+
+	if _, ok := includes.Contains("stake_tallies"); ok {
+		stakeTalliesIncluder := serialization.NewStakeTalliesIncluder(votingsJSON, h.entityManager)
+		stakeTalliesIncluder.Include("voting_id")
+	}
+
+	if _, ok := includes.Contains("config"); ok {
+		votingConfigIncluder := serialization.NewVotingConfigIncluder(votingsJSON, h.entityManager)
+		votingConfigIncluder.Include("voting_id")
+	}
+
+	if arg, ok := includes.ContainsFunc("account_vote"); ok {
+		voteIncluder := serialization.NewAccountVoteIncluder(votingsJSON, h.entityManager)
+		voteIncluder.Include(arg, "voting_id")
+	}
+
+	http_response.Success(w, votingsJSON[0])
+}
+
+func parseStakeTypeIDs(r *http.Request) ([]entities.StakeTypeID, error) {
+	rawStakeTypeIDs, err := http_params.ParseOptionalUint16List("stake_type_id", r)
+	if err != nil {
+		return nil, err
+	}
+
+	stakeTypeIDs := make([]entities.StakeTypeID, 0, len(rawStakeTypeIDs))
+	for _, stakeTypeID := range rawStakeTypeIDs {
+		stakeTypeIDs = append(stakeTypeIDs, entities.StakeTypeID(stakeTypeID))
+	}
+
+	return stakeTypeIDs, nil
+}
+
+// HandleGetVotingProjection
+//
+//	@Summary	Return the likely outcome of the current voting stage by the contract rules applied to the current ballots
+//
+//	@Router		/votings/{voting_id}/projection [GET]
+//
+//	@Param		voting_id	path		uint	true	"VotingID uint"
+//
+//	@Success	200			{object}	http_response.SuccessResponse{data=entities.VotingProjection}
+//	@Failure	400,404,500	{object}	http_response.ErrorResponse{error=http_response.ErrorResult}
+//
+//	@tags		Voting
+func (h *Voting) HandleGetVotingProjection(w http.ResponseWriter, r *http.Request) {
+	votingID, err := http_params.ParseUint32("voting_id", r)
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	getVotingProjection := voting.NewGetVotingProjection()
+	getVotingProjection.SetEntityManager(h.entityManager)
+	getVotingProjection.SetVotingID(votingID)
+
+	projection, err := getVotingProjection.Execute()
+	if err != nil {
+		http_response.Error(w, r, err)
+		return
+	}
+
+	http_response.Success(w, projection)
+}
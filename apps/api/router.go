@@ -44,21 +44,53 @@ func NewRouter(
 	settingHandler := handlers.NewSetting(entityManager)
 	accountHandler := handlers.NewAccount(entityManager)
 	jobOffersHandler := handlers.NewJobOffer(entityManager)
+	onboardingRequestHandler := handlers.NewOnboardingRequest(entityManager)
+	slashingHandler := handlers.NewSlashing(entityManager)
+	statsHandler := handlers.NewStats(entityManager)
 
 	router.Get("/accounts/{address}/total-reputation-snapshots", reputationHandler.HandleGetTotalReputationSnapshots)
+	router.Get("/accounts/{address}/reputation", reputationHandler.HandleGetAccountReputation)
+	router.Get("/accounts/{address}/reputation-changes", reputationHandler.HandleGetAccountReputationChanges)
 	router.Get("/accounts/{address}/votes", accountHandler.HandleGetAccountVotes)
+	router.Get("/accounts/{address}/pending", accountHandler.HandleGetAccountPendingActions)
+	router.Get("/accounts/{address}/activity", accountHandler.HandleGetAccountActivity)
 	router.Get("/accounts", accountHandler.HandleGetAccounts)
 	router.Get("/accounts/{address}", accountHandler.HandleGetAccountsByAddress)
 
+	router.Get("/reputation/leaderboard", reputationHandler.HandleGetReputationLeaderboard)
+
 	router.Get("/votings", votingHandler.HandleGetVotings)
+	router.Get("/votings/{voting_id}", votingHandler.HandleGetVotingByID)
 	router.Get("/votings/{voting_id}/votes", votingHandler.HandleGetVotingVotes)
+	router.Get("/votings/{voting_id}/projection", votingHandler.HandleGetVotingProjection)
 
 	router.Get("/settings", settingHandler.HandleGetSettings)
+	router.Get("/settings/proposals", settingHandler.HandleGetSettingProposals)
+	router.Get("/settings/{name}", settingHandler.HandleGetSettingByName)
+	router.Get("/settings/{name}/history", settingHandler.HandleGetSettingHistory)
 	router.Get("/job-offers", jobOffersHandler.HandleGetJobOffers)
+	router.Get("/job-offers/{job_offer_id}", jobOffersHandler.HandleGetJobOfferByID)
 	router.Get("/job-offers/{job_offer_id}/bids", jobOffersHandler.HandleGetJobOfferBids)
 	router.Get("/bids/{bid_id}/job", jobOffersHandler.HandleGetBidJob)
+	router.Get("/accounts/{address}/job-offers", jobOffersHandler.HandleGetAccountJobOffers)
+	router.Get("/accounts/{address}/bids", jobOffersHandler.HandleGetAccountBids)
+	router.Get("/accounts/{address}/jobs", jobOffersHandler.HandleGetAccountJobs)
+	router.Get("/accounts/{address}/bid-escrow-stats", jobOffersHandler.HandleGetAccountBidEscrowStats)
+	router.Get("/accounts/{address}/payments", jobOffersHandler.HandleGetAccountPayments)
 	router.Get("/job-statuses", jobOffersHandler.HandleGetJobStatuses)
 	router.Get("/jobs/{job_id}", jobOffersHandler.HandleGetJobByID)
+	router.Get("/jobs/{job_id}/history", jobOffersHandler.HandleGetJobHistory)
+	router.Get("/jobs/{job_id}/payments", jobOffersHandler.HandleGetJobPayments)
+
+	router.Get("/onboarding-requests", onboardingRequestHandler.HandleGetOnboardingRequests)
+	router.Get("/onboarding-requests/{voting_id}", onboardingRequestHandler.HandleGetOnboardingRequestByID)
+
+	router.Get("/slashings", slashingHandler.HandleGetSlashings)
+	router.Get("/accounts/{address}/slashings", slashingHandler.HandleGetAccountSlashings)
+
+	router.Get("/stats/timeseries", statsHandler.HandleGetTimeSeries)
+	router.Get("/stats/participation", statsHandler.HandleGetVotingTypeParticipation)
+	router.Get("/stats/participation/votings", statsHandler.HandleGetVotingParticipation)
 
 	swaggerHost := string(cfg.Addr)
 	if envHost := os.Getenv("SWAGGER_HOST"); envHost != "" {
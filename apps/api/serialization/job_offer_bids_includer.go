@@ -0,0 +1,72 @@
+package serialization
+
+import (
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/persistence"
+	"casper-dao-middleware/internal/dao/services/bid"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/serialize"
+
+	"go.uber.org/zap"
+)
+
+// maxIncludedListSize limits the number of items included as list into the single entity
+const maxIncludedListSize = 1000
+
+type JobOfferBidsIncluder struct {
+	entityManager persistence.EntityManager
+	entitiesJSON  []map[string]interface{}
+}
+
+func NewJobOfferBidsIncluder(entitiesJSON []map[string]interface{}, entityManager persistence.EntityManager) JobOfferBidsIncluder {
+	return JobOfferBidsIncluder{
+		entitiesJSON:  entitiesJSON,
+		entityManager: entityManager,
+	}
+}
+
+// Include map list of JobOffer Bids to target JSON, the picked bid is marked with picked_by_job_poster
+func (s *JobOfferBidsIncluder) Include(jsonMapKey string) {
+	mapJSONCallback := func(entityJSON map[string]interface{}) uint32 {
+		jobOfferID, _ := entityJSON[jsonMapKey].(float64)
+		return uint32(jobOfferID)
+	}
+
+	jobOfferIDs := make([]uint32, 0, len(s.entitiesJSON))
+	for index := range s.entitiesJSON {
+		jobOfferIDs = append(jobOfferIDs, mapJSONCallback(s.entitiesJSON[index]))
+	}
+
+	getBids := bid.NewGetBids()
+	getBids.SetEntityManager(s.entityManager)
+	getBids.SetJobOfferIDs(jobOfferIDs)
+	getBids.SetPaginationParams(&pagination.Params{
+		OrderBy:        []string{"timestamp"},
+		OrderDirection: pagination.OrderDirectionASC,
+		Page:           1,
+		PageSize:       maxIncludedListSize,
+	})
+
+	paginatedBids, err := getBids.Execute()
+	if err != nil {
+		zap.S().With(zap.Error(err)).Warn("Unable to find Bids for including")
+		return
+	}
+	bids := paginatedBids.Data.([]*entities.Bid)
+
+	bidsMap := make(map[uint32][]*entities.Bid)
+	for _, bid := range bids {
+		bidsMap[bid.JobOfferID] = append(bidsMap[bid.JobOfferID], bid)
+	}
+
+	for index := range s.entitiesJSON {
+		mapJSONValue := mapJSONCallback(s.entitiesJSON[index])
+
+		jobOfferBids, ok := bidsMap[mapJSONValue]
+		if !ok {
+			jobOfferBids = make([]*entities.Bid, 0)
+		}
+
+		s.entitiesJSON[index]["bids"] = serialize.ToRawJSONList(jobOfferBids)
+	}
+}
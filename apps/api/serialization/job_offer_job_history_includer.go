@@ -0,0 +1,82 @@
+package serialization
+
+import (
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/persistence"
+	"casper-dao-middleware/internal/dao/services/jobs"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/serialize"
+
+	"go.uber.org/zap"
+)
+
+type JobOfferJobHistoryIncluder struct {
+	entityManager persistence.EntityManager
+	entitiesJSON  []map[string]interface{}
+}
+
+func NewJobOfferJobHistoryIncluder(entitiesJSON []map[string]interface{}, entityManager persistence.EntityManager) JobOfferJobHistoryIncluder {
+	return JobOfferJobHistoryIncluder{
+		entitiesJSON:  entitiesJSON,
+		entityManager: entityManager,
+	}
+}
+
+// Include map list of status changes of the JobOffer Job to target JSON
+func (s *JobOfferJobHistoryIncluder) Include(jsonMapKey string) {
+	mapJSONCallback := func(entityJSON map[string]interface{}) uint32 {
+		jobOfferID, _ := entityJSON[jsonMapKey].(float64)
+		return uint32(jobOfferID)
+	}
+
+	jobOfferIDs := make([]uint32, 0, len(s.entitiesJSON))
+	for index := range s.entitiesJSON {
+		jobOfferIDs = append(jobOfferIDs, mapJSONCallback(s.entitiesJSON[index]))
+	}
+
+	jobsMap, err := findJobOffersJobs(s.entityManager, jobOfferIDs)
+	if err != nil {
+		zap.S().With(zap.Error(err)).Warn("Unable to find Jobs for including job history")
+		return
+	}
+
+	historyMap := make(map[uint32][]entities.JobStatusChange)
+
+	if len(jobsMap) != 0 {
+		jobIDs := make([]uint32, 0, len(jobsMap))
+		for _, job := range jobsMap {
+			jobIDs = append(jobIDs, job.JobID)
+		}
+
+		getStatusChanges := jobs.NewGetJobStatusChanges()
+		getStatusChanges.SetEntityManager(s.entityManager)
+		getStatusChanges.SetJobIDs(jobIDs)
+		getStatusChanges.SetPaginationParams(&pagination.Params{
+			OrderBy:        []string{"timestamp"},
+			OrderDirection: pagination.OrderDirectionASC,
+			Page:           1,
+			PageSize:       maxIncludedListSize,
+		})
+
+		paginatedChanges, err := getStatusChanges.Execute()
+		if err != nil {
+			zap.S().With(zap.Error(err)).Warn("Unable to find Job status changes for including")
+			return
+		}
+
+		for _, change := range paginatedChanges.Data.([]entities.JobStatusChange) {
+			historyMap[change.JobID] = append(historyMap[change.JobID], change)
+		}
+	}
+
+	for index := range s.entitiesJSON {
+		mapJSONValue := mapJSONCallback(s.entitiesJSON[index])
+
+		history := make([]entities.JobStatusChange, 0)
+		if job, ok := jobsMap[mapJSONValue]; ok {
+			history = append(history, historyMap[job.JobID]...)
+		}
+
+		s.entitiesJSON[index]["job_history"] = serialize.ToRawJSONList(history)
+	}
+}
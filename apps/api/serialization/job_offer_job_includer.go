@@ -0,0 +1,112 @@
+package serialization
+
+import (
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/persistence"
+	"casper-dao-middleware/internal/dao/services/bid"
+	"casper-dao-middleware/internal/dao/services/jobs"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/serialize"
+	pkgTypes "casper-dao-middleware/pkg/types"
+	optional_data "casper-dao-middleware/pkg/types/optional-data"
+
+	"go.uber.org/zap"
+)
+
+type JobOfferJobIncluder struct {
+	entityManager persistence.EntityManager
+	entitiesJSON  []map[string]interface{}
+}
+
+func NewJobOfferJobIncluder(entitiesJSON []map[string]interface{}, entityManager persistence.EntityManager) JobOfferJobIncluder {
+	return JobOfferJobIncluder{
+		entitiesJSON:  entitiesJSON,
+		entityManager: entityManager,
+	}
+}
+
+// Include map Job created from the picked JobOffer Bid to target JSON
+func (s *JobOfferJobIncluder) Include(optData *pkgTypes.OptionalData, jsonMapKey string) {
+	mapJSONCallback := func(entityJSON map[string]interface{}) uint32 {
+		jobOfferID, _ := entityJSON[jsonMapKey].(float64)
+		return uint32(jobOfferID)
+	}
+
+	jobOfferIDs := make([]uint32, 0, len(s.entitiesJSON))
+	for index := range s.entitiesJSON {
+		jobOfferIDs = append(jobOfferIDs, mapJSONCallback(s.entitiesJSON[index]))
+	}
+
+	jobsMap, err := findJobOffersJobs(s.entityManager, jobOfferIDs)
+	if err != nil {
+		zap.S().With(zap.Error(err)).Warn("Unable to find Jobs for including")
+		return
+	}
+
+	for index := range s.entitiesJSON {
+		mapJSONValue := mapJSONCallback(s.entitiesJSON[index])
+
+		var optionalData map[string]interface{}
+		if job, ok := jobsMap[mapJSONValue]; ok {
+			optionalData = serialize.ToRawJSON(job)
+		}
+
+		err := optional_data.MapJSON(optData, s.entitiesJSON[index], optionalData)
+		if err != nil {
+			zap.S().With(zap.Error(err)).Info("Error on mapping optional Job data")
+		}
+	}
+}
+
+// findJobOffersJobs returns Jobs mapped by JobOfferID, Job is linked to JobOffer through the picked Bid
+func findJobOffersJobs(entityManager persistence.EntityManager, jobOfferIDs []uint32) (map[uint32]*entities.Job, error) {
+	isPicked := true
+
+	getBids := bid.NewGetBids()
+	getBids.SetEntityManager(entityManager)
+	getBids.SetJobOfferIDs(jobOfferIDs)
+	getBids.SetIsPicked(&isPicked)
+	getBids.SetPaginationParams(&pagination.Params{
+		OrderDirection: pagination.OrderDirectionDESC,
+		Page:           1,
+		PageSize:       uint64(len(jobOfferIDs)),
+	})
+
+	paginatedBids, err := getBids.Execute()
+	if err != nil {
+		return nil, err
+	}
+	pickedBids := paginatedBids.Data.([]*entities.Bid)
+
+	result := make(map[uint32]*entities.Job)
+	if len(pickedBids) == 0 {
+		return result, nil
+	}
+
+	bidIDToJobOfferID := make(map[uint32]uint32, len(pickedBids))
+	bidIDs := make([]uint32, 0, len(pickedBids))
+	for _, pickedBid := range pickedBids {
+		bidIDToJobOfferID[pickedBid.BidID] = pickedBid.JobOfferID
+		bidIDs = append(bidIDs, pickedBid.BidID)
+	}
+
+	getJobs := jobs.NewGetJobs()
+	getJobs.SetEntityManager(entityManager)
+	getJobs.SetBidIDs(bidIDs)
+	getJobs.SetPaginationParams(&pagination.Params{
+		OrderDirection: pagination.OrderDirectionDESC,
+		Page:           1,
+		PageSize:       uint64(len(bidIDs)),
+	})
+
+	paginatedJobs, err := getJobs.Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, job := range paginatedJobs.Data.([]*entities.Job) {
+		result[bidIDToJobOfferID[job.BidID]] = job
+	}
+
+	return result, nil
+}
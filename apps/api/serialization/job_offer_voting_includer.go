@@ -0,0 +1,84 @@
+package serialization
+
+import (
+	"encoding/json"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/persistence"
+	"casper-dao-middleware/internal/dao/services/voting"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/serialize"
+	pkgTypes "casper-dao-middleware/pkg/types"
+	optional_data "casper-dao-middleware/pkg/types/optional-data"
+
+	"go.uber.org/zap"
+)
+
+type JobOfferVotingIncluder struct {
+	entityManager persistence.EntityManager
+	entitiesJSON  []map[string]interface{}
+}
+
+func NewJobOfferVotingIncluder(entitiesJSON []map[string]interface{}, entityManager persistence.EntityManager) JobOfferVotingIncluder {
+	return JobOfferVotingIncluder{
+		entitiesJSON:  entitiesJSON,
+		entityManager: entityManager,
+	}
+}
+
+// Include map the latest BidEscrow Voting of the JobOffer to target JSON
+func (s *JobOfferVotingIncluder) Include(optData *pkgTypes.OptionalData, jsonMapKey string) {
+	mapJSONCallback := func(entityJSON map[string]interface{}) uint32 {
+		jobOfferID, _ := entityJSON[jsonMapKey].(float64)
+		return uint32(jobOfferID)
+	}
+
+	jobOfferIDs := make([]uint32, 0, len(s.entitiesJSON))
+	for index := range s.entitiesJSON {
+		jobOfferIDs = append(jobOfferIDs, mapJSONCallback(s.entitiesJSON[index]))
+	}
+
+	getVotings := voting.NewGetVotings()
+	getVotings.SetEntityManager(s.entityManager)
+	getVotings.SetJobOfferIDs(jobOfferIDs)
+	getVotings.SetPaginationParams(&pagination.Params{
+		OrderBy:        []string{"voting_id"},
+		OrderDirection: pagination.OrderDirectionASC,
+		Page:           1,
+		PageSize:       maxIncludedListSize,
+	})
+
+	paginatedVotings, err := getVotings.Execute()
+	if err != nil {
+		zap.S().With(zap.Error(err)).Warn("Unable to find BidEscrow Votings for including")
+		return
+	}
+	votings := paginatedVotings.Data.([]*entities.Voting)
+
+	// votings are ordered by voting_id, so the latest voting of the JobOffer wins
+	votingsMap := make(map[uint32]*entities.Voting)
+	for _, voting := range votings {
+		var metadata struct {
+			JobOfferID uint32 `json:"job_offer_id"`
+		}
+		if err := json.Unmarshal(voting.Metadata, &metadata); err != nil {
+			zap.S().With(zap.Error(err)).Info("Invalid BidEscrow Voting metadata")
+			continue
+		}
+		votingsMap[metadata.JobOfferID] = voting
+	}
+
+	for index := range s.entitiesJSON {
+		mapJSONValue := mapJSONCallback(s.entitiesJSON[index])
+
+		var optionalData map[string]interface{}
+		if voting, ok := votingsMap[mapJSONValue]; ok {
+			optionalData = serialize.ToRawJSON(voting)
+		}
+
+		err := optional_data.MapJSON(optData, s.entitiesJSON[index], optionalData)
+		if err != nil {
+			zap.S().With(zap.Error(err)).Info("Error on mapping optional BidEscrow Voting data")
+		}
+	}
+}
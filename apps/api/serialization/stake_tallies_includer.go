@@ -0,0 +1,55 @@
+package serialization
+
+import (
+	"go.uber.org/zap"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/persistence"
+	"casper-dao-middleware/internal/dao/services/votes"
+)
+
+type StakeTalliesIncluder struct {
+	entityManager persistence.EntityManager
+	entitiesJSON  []map[string]interface{}
+}
+
+func NewStakeTalliesIncluder(entitiesJSON []map[string]interface{}, entityManager persistence.EntityManager) StakeTalliesIncluder {
+	return StakeTalliesIncluder{
+		entitiesJSON:  entitiesJSON,
+		entityManager: entityManager,
+	}
+}
+
+// Include map list of VotingStakeTally to target JSON, reputation and CSPR stakes are tallied separately
+func (s *StakeTalliesIncluder) Include(jsonMapKey string) {
+	mapJSONCallback := func(entityJSON map[string]interface{}) uint32 {
+		votingId, _ := entityJSON[jsonMapKey].(float64)
+		return uint32(votingId)
+	}
+
+	votingIDs := make([]uint32, 0, len(s.entitiesJSON))
+	for index := range s.entitiesJSON {
+		mapJSONValue := mapJSONCallback(s.entitiesJSON[index])
+		votingIDs = append(votingIDs, mapJSONValue)
+	}
+
+	getStakeTallies := votes.NewGetStakeTallies()
+	getStakeTallies.SetEntityManager(s.entityManager)
+	getStakeTallies.SetVotingIDs(votingIDs)
+	stakeTalliesResult, err := getStakeTallies.Execute()
+	if err != nil {
+		zap.S().With(zap.Error(err)).Warn("Unable to find Votes stake tallies for including")
+		return
+	}
+
+	for index := range s.entitiesJSON {
+		mapJSONValue := mapJSONCallback(s.entitiesJSON[index])
+
+		tallies, ok := stakeTalliesResult[mapJSONValue]
+		if !ok {
+			tallies = make([]entities.VotingStakeTally, 0)
+		}
+
+		s.entitiesJSON[index]["stake_tallies"] = tallies
+	}
+}
@@ -0,0 +1,59 @@
+package serialization
+
+import (
+	"go.uber.org/zap"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/persistence"
+	"casper-dao-middleware/internal/dao/services/voting"
+	"casper-dao-middleware/pkg/serialize"
+)
+
+type VotingConfigIncluder struct {
+	entityManager persistence.EntityManager
+	entitiesJSON  []map[string]interface{}
+}
+
+func NewVotingConfigIncluder(entitiesJSON []map[string]interface{}, entityManager persistence.EntityManager) VotingConfigIncluder {
+	return VotingConfigIncluder{
+		entitiesJSON:  entitiesJSON,
+		entityManager: entityManager,
+	}
+}
+
+// Include map VotingConfig with the settings snapshot to target JSON
+func (s *VotingConfigIncluder) Include(jsonMapKey string) {
+	mapJSONCallback := func(entityJSON map[string]interface{}) uint32 {
+		votingId, _ := entityJSON[jsonMapKey].(float64)
+		return uint32(votingId)
+	}
+
+	votingIDs := make([]uint32, 0, len(s.entitiesJSON))
+	for index := range s.entitiesJSON {
+		votingIDs = append(votingIDs, mapJSONCallback(s.entitiesJSON[index]))
+	}
+
+	getVotingConfigs := voting.NewGetVotingConfigs()
+	getVotingConfigs.SetEntityManager(s.entityManager)
+	getVotingConfigs.SetVotingIDs(votingIDs)
+	configs, err := getVotingConfigs.Execute()
+	if err != nil {
+		zap.S().With(zap.Error(err)).Warn("Unable to find Voting configs for including")
+		return
+	}
+
+	votingConfigsMap := make(map[uint32]entities.VotingConfig, len(configs))
+	for _, config := range configs {
+		votingConfigsMap[config.VotingID] = config
+	}
+
+	for index := range s.entitiesJSON {
+		config, ok := votingConfigsMap[mapJSONCallback(s.entitiesJSON[index])]
+		if !ok {
+			s.entitiesJSON[index]["config"] = nil
+			continue
+		}
+
+		s.entitiesJSON[index]["config"] = serialize.ToRawJSON(config)
+	}
+}
@@ -0,0 +1,57 @@
+package serialization
+
+import (
+	"go.uber.org/zap"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/persistence"
+	"casper-dao-middleware/internal/dao/services/voting"
+)
+
+type VotingEffectsIncluder struct {
+	entityManager persistence.EntityManager
+	entitiesJSON  []map[string]interface{}
+}
+
+func NewVotingEffectsIncluder(entitiesJSON []map[string]interface{}, entityManager persistence.EntityManager) VotingEffectsIncluder {
+	return VotingEffectsIncluder{
+		entitiesJSON:  entitiesJSON,
+		entityManager: entityManager,
+	}
+}
+
+// Include map list of VotingEffect executed by the Voting to target JSON
+func (s *VotingEffectsIncluder) Include(jsonMapKey string) {
+	mapJSONCallback := func(entityJSON map[string]interface{}) uint32 {
+		votingId, _ := entityJSON[jsonMapKey].(float64)
+		return uint32(votingId)
+	}
+
+	votingIDs := make([]uint32, 0, len(s.entitiesJSON))
+	for index := range s.entitiesJSON {
+		votingIDs = append(votingIDs, mapJSONCallback(s.entitiesJSON[index]))
+	}
+
+	getVotingEffects := voting.NewGetVotingEffects()
+	getVotingEffects.SetEntityManager(s.entityManager)
+	getVotingEffects.SetVotingIDs(votingIDs)
+	effects, err := getVotingEffects.Execute()
+	if err != nil {
+		zap.S().With(zap.Error(err)).Warn("Unable to find Voting effects for including")
+		return
+	}
+
+	votingEffectsMap := make(map[uint32][]entities.VotingEffect)
+	for _, effect := range effects {
+		votingEffectsMap[effect.VotingID] = append(votingEffectsMap[effect.VotingID], effect)
+	}
+
+	for index := range s.entitiesJSON {
+		votingEffects, ok := votingEffectsMap[mapJSONCallback(s.entitiesJSON[index])]
+		if !ok {
+			votingEffects = make([]entities.VotingEffect, 0)
+		}
+
+		s.entitiesJSON[index]["executed_effects"] = votingEffects
+	}
+}
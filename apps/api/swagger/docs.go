@@ -22,7 +22,7 @@ const docTemplate = `{
         "/accounts": {
             "get": {
                 "tags": [
-                    "Vote"
+                    "Account"
                 ],
                 "summary": "Return paginated list of accounts",
                 "parameters": [
@@ -120,7 +120,7 @@ const docTemplate = `{
         "/accounts/{address}": {
             "get": {
                 "tags": [
-                    "Vote"
+                    "Account"
                 ],
                 "summary": "Return account by its address",
                 "parameters": [
@@ -209,12 +209,12 @@ const docTemplate = `{
                 }
             }
         },
-        "/accounts/{address}/total-reputation-snapshots": {
+        "/accounts/{address}/activity": {
             "get": {
                 "tags": [
-                    "Reputation"
+                    "Account"
                 ],
-                "summary": "Return paginated list of total-reputation-snapshots for account",
+                "summary": "Return paginated time-ordered list of the account activities of all kinds",
                 "parameters": [
                     {
                         "maxLength": 66,
@@ -223,6 +223,42 @@ const docTemplate = `{
                         "name": "address",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "DESC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "timestamp",
+                        "description": "Comma-separated list of sorting fields (timestamp)",
+                        "name": "order_by",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -237,7 +273,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/entities.TotalReputationSnapshot"
+                                            "$ref": "#/definitions/entities.AccountActivity"
                                         }
                                     }
                                 }
@@ -301,12 +337,12 @@ const docTemplate = `{
                 }
             }
         },
-        "/accounts/{address}/votes": {
+        "/accounts/{address}/bid-escrow-stats": {
             "get": {
                 "tags": [
-                    "Vote"
+                    "BidEscrow"
                 ],
-                "summary": "Return paginated list of votes for address",
+                "summary": "Return BidEscrow performance statistics of account as worker and job poster",
                 "parameters": [
                     {
                         "maxLength": 66,
@@ -315,11 +351,103 @@ const docTemplate = `{
                         "name": "address",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.BidEscrowStats"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{address}/bids": {
+            "get": {
+                "tags": [
+                    "BidEscrow"
+                ],
+                "summary": "Return paginated list of bids submitted by account",
+                "parameters": [
                     {
+                        "maxLength": 66,
                         "type": "string",
-                        "description": "Optional fields' schema (voting{})",
-                        "name": "includes",
+                        "description": "Hash or PublicKey",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Picked/not picked by job poster bids filtering",
+                        "name": "is_picked",
                         "in": "query"
                     },
                     {
@@ -342,7 +470,7 @@ const docTemplate = `{
                             "DESC"
                         ],
                         "type": "string",
-                        "default": "ASC",
+                        "default": "DESC",
                         "description": "Sorting direction",
                         "name": "order_direction",
                         "in": "query"
@@ -353,8 +481,8 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "default": "voting_id",
-                        "description": "Comma-separated list of sorting fields (voting_id,address)",
+                        "default": "bid_id",
+                        "description": "Comma-separated list of sorting fields (bid_id,timestamp)",
                         "name": "order_by",
                         "in": "query"
                     }
@@ -371,7 +499,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/entities.Vote"
+                                            "$ref": "#/definitions/entities.Bid"
                                         }
                                     }
                                 }
@@ -435,19 +563,56 @@ const docTemplate = `{
                 }
             }
         },
-        "/bids/{bid_id}/job": {
+        "/accounts/{address}/job-offers": {
             "get": {
                 "tags": [
                     "BidEscrow"
                 ],
-                "summary": "Return Job by BidID",
+                "summary": "Return paginated list of job offers posted by account",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "BidID uint",
-                        "name": "bid_id",
+                        "maxLength": 66,
+                        "type": "string",
+                        "description": "Hash or PublicKey",
+                        "name": "address",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "DESC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "job_offer_id",
+                        "description": "Comma-separated list of sorting fields (job_offer_id,max_budget,timestamp)",
+                        "name": "order_by",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -456,13 +621,13 @@ const docTemplate = `{
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/http_response.SuccessResponse"
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/entities.Job"
+                                            "$ref": "#/definitions/entities.JobOffer"
                                         }
                                     }
                                 }
@@ -526,13 +691,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/job-offers": {
+        "/accounts/{address}/jobs": {
             "get": {
                 "tags": [
                     "BidEscrow"
                 ],
-                "summary": "Return paginated list of votes for votingID",
+                "summary": "Return paginated list of jobs where account is job poster or worker",
                 "parameters": [
+                    {
+                        "maxLength": 66,
+                        "type": "string",
+                        "description": "Hash or PublicKey",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "poster",
+                            "worker"
+                        ],
+                        "type": "string",
+                        "description": "Account role in the job, any role by default",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of job status ids",
+                        "name": "job_status_id",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "default": 1,
@@ -553,7 +746,7 @@ const docTemplate = `{
                             "DESC"
                         ],
                         "type": "string",
-                        "default": "ASC",
+                        "default": "DESC",
                         "description": "Sorting direction",
                         "name": "order_direction",
                         "in": "query"
@@ -564,8 +757,8 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "default": "voting_id",
-                        "description": "Comma-separated list of sorting fields (job_offer_id)",
+                        "default": "job_id",
+                        "description": "Comma-separated list of sorting fields (job_id,timestamp)",
                         "name": "order_by",
                         "in": "query"
                     }
@@ -582,7 +775,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/entities.JobOffer"
+                                            "$ref": "#/definitions/entities.Job"
                                         }
                                     }
                                 }
@@ -646,20 +839,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/job-offers/{job_offer_id}/bids": {
+        "/accounts/{address}/payments": {
             "get": {
                 "tags": [
                     "BidEscrow"
                 ],
-                "summary": "Return paginated list of bid for JobOffer",
+                "summary": "Return paginated list of BidEscrow CSPR flows sent or received by account",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "JobOfferID uint",
-                        "name": "job_offer_id",
+                        "maxLength": 66,
+                        "type": "string",
+                        "description": "Hash or PublicKey",
+                        "name": "address",
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of flow type ids (1 - payment deposit, 2 - stake deposit, 3 - worker payout, 4 - DAO fee, 5 - refund, 6 - forfeited stake, 7 - stake return)",
+                        "name": "cspr_flow_type_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Lower bound of flow timestamp (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Upper bound of flow timestamp (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "default": 1,
@@ -680,7 +896,7 @@ const docTemplate = `{
                             "DESC"
                         ],
                         "type": "string",
-                        "default": "ASC",
+                        "default": "DESC",
                         "description": "Sorting direction",
                         "name": "order_direction",
                         "in": "query"
@@ -691,8 +907,8 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "default": "voting_id",
-                        "description": "Comma-separated list of sorting fields (job_offer_id)",
+                        "default": "timestamp",
+                        "description": "Comma-separated list of sorting fields (timestamp,amount,job_id)",
                         "name": "order_by",
                         "in": "query"
                     }
@@ -709,7 +925,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/entities.Bid"
+                                            "$ref": "#/definitions/entities.CSPRFlow"
                                         }
                                     }
                                 }
@@ -773,12 +989,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/job-statuses": {
+        "/accounts/{address}/pending": {
             "get": {
                 "tags": [
-                    "BidEscrow"
+                    "Account"
+                ],
+                "summary": "Return votings the account has not voted in and jobs waiting for the account action ordered by deadline",
+                "parameters": [
+                    {
+                        "maxLength": 66,
+                        "type": "string",
+                        "description": "Hash or PublicKey",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Return predefined list of JobStatuses",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -793,7 +1019,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/entities.Job"
+                                                "$ref": "#/definitions/entities.PendingAction"
                                             }
                                         }
                                     }
@@ -858,19 +1084,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/jobs/{job_id}": {
+        "/accounts/{address}/reputation": {
             "get": {
                 "tags": [
-                    "BidEscrow"
+                    "Reputation"
                 ],
-                "summary": "Return Job by JobIF",
+                "summary": "Return liquid and staked reputation of the account as of the provided moment",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "JobID uint",
-                        "name": "job_id",
+                        "maxLength": 66,
+                        "type": "string",
+                        "description": "Hash or PublicKey",
+                        "name": "address",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Timestamp (RFC3339) or hash of the deploy which changed the reputation, current balance by default",
+                        "name": "as_of",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -885,7 +1118,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/entities.Job"
+                                            "$ref": "#/definitions/entities.AccountReputation"
                                         }
                                     }
                                 }
@@ -949,13 +1182,69 @@ const docTemplate = `{
                 }
             }
         },
-        "/settings": {
+        "/accounts/{address}/reputation-changes": {
             "get": {
                 "tags": [
-                    "Setting"
+                    "Reputation"
                 ],
-                "summary": "Return paginated list of settings",
+                "summary": "Return paginated list of reputation changes for account",
                 "parameters": [
+                    {
+                        "maxLength": 66,
+                        "type": "string",
+                        "description": "Hash or PublicKey",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of reason ids (1-Minted,2-Burned,3-Staked,4-VotingGained,5-VotingLost,6-Unstaked)",
+                        "name": "reason",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of contract package hashes",
+                        "name": "contract_package_hash",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of VotingIDs",
+                        "name": "voting_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Changes made after the time (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Changes made before the time (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Optional fields' schema (voting{})",
+                        "name": "includes",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "default": 1,
@@ -976,7 +1265,7 @@ const docTemplate = `{
                             "DESC"
                         ],
                         "type": "string",
-                        "default": "ASC",
+                        "default": "DESC",
                         "description": "Sorting direction",
                         "name": "order_direction",
                         "in": "query"
@@ -987,8 +1276,8 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "default": "voting_id",
-                        "description": "Comma-separated list of sorting fields (name)",
+                        "default": "timestamp",
+                        "description": "Comma-separated list of sorting fields (timestamp,amount,voting_id)",
                         "name": "order_by",
                         "in": "query"
                     }
@@ -1005,7 +1294,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/entities.Setting"
+                                            "$ref": "#/definitions/entities.ReputationChange"
                                         }
                                     }
                                 }
@@ -1069,16 +1358,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/votings": {
+        "/accounts/{address}/slashings": {
             "get": {
                 "tags": [
-                    "Voting"
+                    "Slashing"
                 ],
-                "summary": "Return paginated list of votings",
+                "summary": "Return paginated list of slashings started against the account",
                 "parameters": [
+                    {
+                        "maxLength": 66,
+                        "type": "string",
+                        "description": "Hash or PublicKey",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of status ids (1 - pending, 2 - slashed, 3 - rejected, 4 - canceled)",
+                        "name": "slashing_status_id",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Optional fields' schema (votes_number{}, account_vote(hash))",
+                        "description": "Optional fields' schema (voting{})",
                         "name": "includes",
                         "in": "query"
                     },
@@ -1102,7 +1409,7 @@ const docTemplate = `{
                             "DESC"
                         ],
                         "type": "string",
-                        "default": "ASC",
+                        "default": "DESC",
                         "description": "Sorting direction",
                         "name": "order_direction",
                         "in": "query"
@@ -1114,7 +1421,7 @@ const docTemplate = `{
                         },
                         "collectionFormat": "csv",
                         "default": "voting_id",
-                        "description": "Comma-separated list of sorting fields (voting_id)",
+                        "description": "Comma-separated list of sorting fields (voting_id,slash_ratio,reputation_burned,timestamp)",
                         "name": "order_by",
                         "in": "query"
                     }
@@ -1131,7 +1438,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/entities.Voting"
+                                            "$ref": "#/definitions/entities.Slashing"
                                         }
                                     }
                                 }
@@ -1195,23 +1502,121 @@ const docTemplate = `{
                 }
             }
         },
-        "/votings/{voting_id}/votes": {
+        "/accounts/{address}/total-reputation-snapshots": {
+            "get": {
+                "tags": [
+                    "Reputation"
+                ],
+                "summary": "Return paginated list of total-reputation-snapshots for account",
+                "parameters": [
+                    {
+                        "maxLength": 66,
+                        "type": "string",
+                        "description": "Hash or PublicKey",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.TotalReputationSnapshot"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{address}/votes": {
             "get": {
                 "tags": [
                     "Vote"
                 ],
-                "summary": "Return paginated list of votes for votingID",
+                "summary": "Return paginated list of votes for address",
                 "parameters": [
                     {
+                        "maxLength": 66,
                         "type": "string",
-                        "description": "Comma-separated list of VotingIDs (number)",
-                        "name": "voting_id",
-                        "in": "path"
+                        "description": "Hash or PublicKey",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Is formal/informal filtering",
-                        "name": "is_formal",
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of stake type ids (1 - reputation, 2 - CSPR)",
+                        "name": "stake_type_id",
                         "in": "query"
                     },
                     {
@@ -1252,7 +1657,7 @@ const docTemplate = `{
                         },
                         "collectionFormat": "csv",
                         "default": "voting_id",
-                        "description": "Comma-separated list of sorting fields (voting_id, address)",
+                        "description": "Comma-separated list of sorting fields (voting_id,address)",
                         "name": "order_by",
                         "in": "query"
                     }
@@ -1332,95 +1737,3394 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "entities.Account": {
-            "type": "object",
-            "properties": {
-                "hash": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
-                "is_kyc": {
-                    "type": "boolean"
-                },
-                "is_va": {
-                    "type": "boolean"
-                },
-                "timestamp": {
-                    "type": "string"
-                }
-            }
         },
-        "entities.AuctionTypeID": {
-            "type": "integer",
-            "enum": [
-                1,
-                2
-            ],
-            "x-enum-varnames": [
-                "AuctionTypeIDInternal",
-                "AuctionTypeIDExternal"
-            ]
-        },
-        "entities.Bid": {
-            "type": "object",
-            "properties": {
-                "bid_id": {
-                    "type": "integer"
-                },
-                "cspr_stake": {
-                    "type": "integer"
-                },
-                "deploy_hash": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
-                "job_offer_id": {
-                    "type": "integer"
-                },
-                "onboard": {
-                    "type": "boolean"
-                },
+        "/bids/{bid_id}/job": {
+            "get": {
+                "tags": [
+                    "BidEscrow"
+                ],
+                "summary": "Return Job by BidID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "BidID uint",
+                        "name": "bid_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Job"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/job-offers": {
+            "get": {
+                "tags": [
+                    "BidEscrow"
+                ],
+                "summary": "Return paginated list of job offers",
+                "parameters": [
+                    {
+                        "maxLength": 66,
+                        "type": "string",
+                        "description": "JobPoster Hash or PublicKey",
+                        "name": "job_poster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of auction type ids (1-Internal,2-External)",
+                        "name": "auction_type_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimal max budget",
+                        "name": "max_budget_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximal max budget",
+                        "name": "max_budget_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimal expected time frame",
+                        "name": "expected_time_frame_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximal expected time frame",
+                        "name": "expected_time_frame_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Job offers created after the time (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Job offers created before the time (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "open",
+                            "has_job"
+                        ],
+                        "type": "string",
+                        "description": "Job offer state",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of job status ids of the job offer job",
+                        "name": "job_status_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "ASC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "job_offer_id",
+                        "description": "Comma-separated list of sorting fields (job_offer_id,max_budget,expected_time_frame,timestamp)",
+                        "name": "order_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.JobOffer"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/job-offers/{job_offer_id}": {
+            "get": {
+                "tags": [
+                    "BidEscrow"
+                ],
+                "summary": "Return JobOffer by JobOfferID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "JobOfferID uint",
+                        "name": "job_offer_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Optional fields' schema (bids{}, job{}, job_history{}, voting{})",
+                        "name": "includes",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.JobOffer"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/job-offers/{job_offer_id}/bids": {
+            "get": {
+                "tags": [
+                    "BidEscrow"
+                ],
+                "summary": "Return paginated list of bid for JobOffer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "JobOfferID uint",
+                        "name": "job_offer_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "ASC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "voting_id",
+                        "description": "Comma-separated list of sorting fields (job_offer_id)",
+                        "name": "order_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Bid"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/job-statuses": {
+            "get": {
+                "tags": [
+                    "BidEscrow"
+                ],
+                "summary": "Return predefined list of JobStatuses",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.Job"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/{job_id}": {
+            "get": {
+                "tags": [
+                    "BidEscrow"
+                ],
+                "summary": "Return Job by JobIF",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "JobID uint",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Job"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/{job_id}/history": {
+            "get": {
+                "tags": [
+                    "BidEscrow"
+                ],
+                "summary": "Return paginated list of Job status transitions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "JobID uint",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "ASC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "timestamp",
+                        "description": "Comma-separated list of sorting fields (timestamp)",
+                        "name": "order_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.JobStatusChange"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/{job_id}/payments": {
+            "get": {
+                "tags": [
+                    "BidEscrow"
+                ],
+                "summary": "Return paginated list of CSPR flows of the Job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "JobID uint",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of flow type ids (1 - payment deposit, 2 - stake deposit, 3 - worker payout, 4 - DAO fee, 5 - refund, 6 - forfeited stake, 7 - stake return)",
+                        "name": "cspr_flow_type_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "ASC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "timestamp",
+                        "description": "Comma-separated list of sorting fields (timestamp,amount)",
+                        "name": "order_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.CSPRFlow"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/onboarding-requests": {
+            "get": {
+                "tags": [
+                    "Onboarding"
+                ],
+                "summary": "Return paginated list of onboarding requests",
+                "parameters": [
+                    {
+                        "maxLength": 66,
+                        "type": "string",
+                        "description": "Hash or PublicKey of requester",
+                        "name": "requester",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of status ids (1 - pending, 2 - accepted, 3 - rejected, 4 - canceled)",
+                        "name": "onboarding_request_status_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Optional fields' schema (voting{})",
+                        "name": "includes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "DESC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "voting_id",
+                        "description": "Comma-separated list of sorting fields (voting_id,cspr_deposit,timestamp)",
+                        "name": "order_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.OnboardingRequest"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/onboarding-requests/{voting_id}": {
+            "get": {
+                "tags": [
+                    "Onboarding"
+                ],
+                "summary": "Return onboarding request by its voting id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "VotingID uint",
+                        "name": "voting_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Optional fields' schema (voting{})",
+                        "name": "includes",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.OnboardingRequest"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/reputation/leaderboard": {
+            "get": {
+                "tags": [
+                    "Reputation"
+                ],
+                "summary": "Return paginated list of accounts ordered by aggregated reputation",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Is VA/non VA accounts filtering",
+                        "name": "is_va",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Aggregate only reputation changes made after the time (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Aggregate only reputation changes made before the time (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "DESC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "total_reputation",
+                        "description": "Comma-separated list of sorting fields (total_reputation,liquid_reputation,staked_reputation)",
+                        "name": "order_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.AggregatedReputationBalance"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/settings": {
+            "get": {
+                "tags": [
+                    "Setting"
+                ],
+                "summary": "Return paginated list of settings with values decoded by their type and the setting definition",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "ASC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "voting_id",
+                        "description": "Comma-separated list of sorting fields (name)",
+                        "name": "order_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Setting"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/settings/proposals": {
+            "get": {
+                "tags": [
+                    "Setting"
+                ],
+                "summary": "Return paginated list of setting changes proposed by Repo Voter votings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Setting name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of status ids (1 - pending, 2 - accepted, 3 - rejected, 4 - canceled)",
+                        "name": "setting_proposal_status_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Optional fields' schema (voting{})",
+                        "name": "includes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "DESC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "voting_id",
+                        "description": "Comma-separated list of sorting fields (voting_id,activation_time,timestamp)",
+                        "name": "order_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.SettingProposal"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/settings/{name}": {
+            "get": {
+                "tags": [
+                    "Setting"
+                ],
+                "summary": "Return setting by name with its pending value, or the value effective at the provided time",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Setting name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time to return the value effective at (RFC3339)",
+                        "name": "effective_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Setting"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/settings/{name}/history": {
+            "get": {
+                "tags": [
+                    "Setting"
+                ],
+                "summary": "Return paginated list of setting value changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Setting name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "DESC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "timestamp",
+                        "description": "Comma-separated list of sorting fields (timestamp,effective_at)",
+                        "name": "order_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.SettingChange"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/slashings": {
+            "get": {
+                "tags": [
+                    "Slashing"
+                ],
+                "summary": "Return paginated list of slashings",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of status ids (1 - pending, 2 - slashed, 3 - rejected, 4 - canceled)",
+                        "name": "slashing_status_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Optional fields' schema (voting{})",
+                        "name": "includes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "DESC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "voting_id",
+                        "description": "Comma-separated list of sorting fields (voting_id,slash_ratio,reputation_burned,timestamp)",
+                        "name": "order_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Slashing"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/participation": {
+            "get": {
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Return participation of not canceled votings aggregated by voting type, averages are calculated over the ended voting stages",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.VotingTypeParticipation"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/participation/votings": {
+            "get": {
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Return paginated list of per voting turnout, staked reputation share and informal to formal vote switches",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of voting type ids",
+                        "name": "voting_type_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "DESC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "voting_id",
+                        "description": "Comma-separated list of sorting fields (voting_id, voting_type_id)",
+                        "name": "order_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.VotingParticipation"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/timeseries": {
+            "get": {
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Return DAO-wide metric aggregated per day, week or month, periods without values are omitted",
+                "parameters": [
+                    {
+                        "enum": [
+                            "votings_created",
+                            "ballots_cast",
+                            "unique_voters",
+                            "reputation_minted",
+                            "reputation_burned",
+                            "new_vas",
+                            "new_kyc_accounts",
+                            "job_offers_posted",
+                            "jobs_completed"
+                        ],
+                        "type": "string",
+                        "description": "Metric name",
+                        "name": "metric",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "default": "day",
+                        "description": "Aggregation interval, weeks start on Monday",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Inclusive period start in RFC3339 format",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Exclusive period end in RFC3339 format",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.TimeSeriesPoint"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/votings": {
+            "get": {
+                "tags": [
+                    "Voting"
+                ],
+                "summary": "Return paginated list of votings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Optional fields' schema (votes_number{}, stake_tallies{}, executed_effects{}, config{}, account_vote(hash))",
+                        "name": "includes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "ASC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "voting_id",
+                        "description": "Comma-separated list of sorting fields (voting_id)",
+                        "name": "order_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Voting"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/votings/{voting_id}": {
+            "get": {
+                "tags": [
+                    "Voting"
+                ],
+                "summary": "Return voting by id with the on-chain effects executed on its end",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "VotingID uint",
+                        "name": "voting_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Optional fields' schema (votes_number{}, stake_tallies{}, config{}, account_vote(hash))",
+                        "name": "includes",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Voting"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/votings/{voting_id}/projection": {
+            "get": {
+                "tags": [
+                    "Voting"
+                ],
+                "summary": "Return the likely outcome of the current voting stage by the contract rules applied to the current ballots",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "VotingID uint",
+                        "name": "voting_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.VotingProjection"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/votings/{voting_id}/votes": {
+            "get": {
+                "tags": [
+                    "Vote"
+                ],
+                "summary": "Return paginated list of votes for votingID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of VotingIDs (number)",
+                        "name": "voting_id",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Is formal/informal filtering",
+                        "name": "is_formal",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Comma-separated list of stake type ids (1 - reputation, 2 - CSPR)",
+                        "name": "stake_type_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Optional fields' schema (voting{})",
+                        "name": "includes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "ASC",
+                        "description": "Sorting direction",
+                        "name": "order_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "default": "voting_id",
+                        "description": "Comma-separated list of sorting fields (voting_id, address)",
+                        "name": "order_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Vote"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/http_response.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "error": {
+                                            "$ref": "#/definitions/http_response.ErrorResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "casper.ContractPackageHash": {
+            "type": "object",
+            "properties": {
+                "Hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "entities.Account": {
+            "type": "object",
+            "properties": {
+                "hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "is_kyc": {
+                    "type": "boolean"
+                },
+                "is_va": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.AccountActivity": {
+            "type": "object",
+            "properties": {
+                "activity_type": {
+                    "$ref": "#/definitions/entities.AccountActivityType"
+                },
+                "deploy_hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "entity_id": {
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.AccountActivityType": {
+            "type": "string",
+            "enum": [
+                "vote_cast",
+                "ballot_canceled",
+                "voting_created",
+                "reputation_changed",
+                "kyc_granted",
+                "va_granted",
+                "kyc_revoked",
+                "va_revoked",
+                "job_offer_posted",
+                "bid_submitted",
+                "job_created",
+                "job_submitted",
+                "job_cancelled",
+                "job_done",
+                "job_rejected"
+            ],
+            "x-enum-varnames": [
+                "AccountActivityTypeVoteCast",
+                "AccountActivityTypeBallotCanceled",
+                "AccountActivityTypeVotingCreated",
+                "AccountActivityTypeReputationChanged",
+                "AccountActivityTypeKYCGranted",
+                "AccountActivityTypeVAGranted",
+                "AccountActivityTypeKYCRevoked",
+                "AccountActivityTypeVARevoked",
+                "AccountActivityTypeJobOfferPosted",
+                "AccountActivityTypeBidSubmitted",
+                "AccountActivityTypeJobCreated",
+                "AccountActivityTypeJobSubmitted",
+                "AccountActivityTypeJobCancelled",
+                "AccountActivityTypeJobDone",
+                "AccountActivityTypeJobRejected"
+            ]
+        },
+        "entities.AccountReputation": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "as_of": {
+                    "type": "string"
+                },
+                "liquid_reputation": {
+                    "type": "integer"
+                },
+                "staked_by_contract_package": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ReputationBalance"
+                    }
+                },
+                "staked_reputation": {
+                    "type": "integer"
+                },
+                "total_reputation": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.AggregatedReputationBalance": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "liquid_reputation": {
+                    "type": "integer"
+                },
+                "staked_reputation": {
+                    "type": "integer"
+                },
+                "total_reputation": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.AuctionTypeID": {
+            "type": "integer",
+            "enum": [
+                1,
+                2
+            ],
+            "x-enum-varnames": [
+                "AuctionTypeIDInternal",
+                "AuctionTypeIDExternal"
+            ]
+        },
+        "entities.Bid": {
+            "type": "object",
+            "properties": {
+                "bid_id": {
+                    "type": "integer"
+                },
+                "cspr_stake": {
+                    "type": "integer"
+                },
+                "deploy_hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "job_offer_id": {
+                    "type": "integer"
+                },
+                "onboard": {
+                    "type": "boolean"
+                },
                 "picked_by_job_poster": {
                     "type": "boolean"
                 },
-                "proposed_payment": {
+                "proposed_payment": {
+                    "type": "integer"
+                },
+                "proposed_time_frame": {
+                    "type": "integer"
+                },
+                "reputation_stake": {
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "worker": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "entities.BidEscrowStats": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "avg_actual_time_frame": {
+                    "type": "integer"
+                },
+                "avg_proposed_time_frame": {
+                    "type": "integer"
+                },
+                "bids_submitted": {
+                    "type": "integer"
+                },
+                "bids_won": {
+                    "type": "integer"
+                },
+                "job_offers_completed": {
+                    "type": "integer"
+                },
+                "job_offers_posted": {
+                    "description": "job poster stats",
+                    "type": "integer"
+                },
+                "jobs_cancelled": {
+                    "type": "integer"
+                },
+                "jobs_done": {
+                    "description": "worker stats",
+                    "type": "integer"
+                },
+                "jobs_rejected": {
+                    "type": "integer"
+                },
+                "total_proposed_payment": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.CSPRFlow": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "bid_id": {
+                    "type": "integer"
+                },
+                "cspr_flow_type_id": {
+                    "$ref": "#/definitions/entities.CSPRFlowTypeID"
+                },
+                "deploy_hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "job_id": {
+                    "type": "integer"
+                },
+                "job_offer_id": {
+                    "type": "integer"
+                },
+                "recipient": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "sender": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CSPRFlowTypeID": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3,
+                4,
+                5,
+                6,
+                7
+            ],
+            "x-enum-varnames": [
+                "CSPRFlowTypeIDPaymentDeposit",
+                "CSPRFlowTypeIDStakeDeposit",
+                "CSPRFlowTypeIDWorkerPayout",
+                "CSPRFlowTypeIDDAOFee",
+                "CSPRFlowTypeIDRefund",
+                "CSPRFlowTypeIDForfeitedStake",
+                "CSPRFlowTypeIDStakeReturn"
+            ]
+        },
+        "entities.DepositStatusID": {
+            "type": "integer",
+            "enum": [
+                1,
+                2
+            ],
+            "x-enum-varnames": [
+                "DepositStatusIDRefunded",
+                "DepositStatusIDForfeited"
+            ]
+        },
+        "entities.Job": {
+            "type": "object",
+            "properties": {
+                "bid_id": {
+                    "type": "integer"
+                },
+                "caller": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "deploy_hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "finish_time": {
+                    "type": "integer"
+                },
+                "job_id": {
+                    "type": "integer"
+                },
+                "job_poster": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "job_status_id": {
+                    "$ref": "#/definitions/entities.JobStatusID"
+                },
+                "result": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "worker": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "entities.JobOffer": {
+            "type": "object",
+            "properties": {
+                "auction_type_id": {
+                    "$ref": "#/definitions/entities.AuctionTypeID"
+                },
+                "deploy_hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "expected_time_frame": {
+                    "type": "integer"
+                },
+                "job_offer_id": {
+                    "type": "integer"
+                },
+                "job_poster": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "max_budget": {
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.JobStatusChange": {
+            "type": "object",
+            "properties": {
+                "bid_id": {
+                    "type": "integer"
+                },
+                "caller": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "deploy_hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "is_restored": {
+                    "description": "IsRestored marks transitions restored from already tracked jobs, their timestamp is not the deploy time",
+                    "type": "boolean"
+                },
+                "job_id": {
+                    "type": "integer"
+                },
+                "job_status_id": {
+                    "$ref": "#/definitions/entities.JobStatusID"
+                },
+                "result": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.JobStatusID": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3,
+                4,
+                5
+            ],
+            "x-enum-varnames": [
+                "JobStatusIDCreated",
+                "JobStatusIDSubmitted",
+                "JobStatusIDCancelled",
+                "JobStatusIDDone",
+                "JobStatusIDRejected"
+            ]
+        },
+        "entities.OnboardingRequest": {
+            "type": "object",
+            "properties": {
+                "cspr_deposit": {
+                    "type": "integer"
+                },
+                "deploy_hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "deposit_status_id": {
+                    "$ref": "#/definitions/entities.DepositStatusID"
+                },
+                "onboarding_request_status_id": {
+                    "$ref": "#/definitions/entities.OnboardingRequestStatusID"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "requester": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "va_granted_deploy_hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "va_token_id": {
+                    "type": "integer"
+                },
+                "voting_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.OnboardingRequestStatusID": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3,
+                4
+            ],
+            "x-enum-varnames": [
+                "OnboardingRequestStatusIDPending",
+                "OnboardingRequestStatusIDAccepted",
+                "OnboardingRequestStatusIDRejected",
+                "OnboardingRequestStatusIDCanceled"
+            ]
+        },
+        "entities.PendingAction": {
+            "type": "object",
+            "properties": {
+                "deadline": {
+                    "type": "string"
+                },
+                "is_formal": {
+                    "type": "boolean"
+                },
+                "job_id": {
+                    "type": "integer"
+                },
+                "pending_action_type_id": {
+                    "$ref": "#/definitions/entities.PendingActionTypeID"
+                },
+                "voting_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.PendingActionTypeID": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3
+            ],
+            "x-enum-varnames": [
+                "PendingActionTypeIDVote",
+                "PendingActionTypeIDSubmitJob",
+                "PendingActionTypeIDCancelJob"
+            ]
+        },
+        "entities.ReputationBalance": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "amount": {
+                    "type": "integer"
+                },
+                "contract_package_hash": {
+                    "$ref": "#/definitions/casper.ContractPackageHash"
+                }
+            }
+        },
+        "entities.ReputationChange": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "amount": {
+                    "type": "integer"
+                },
+                "contract_package_hash": {
+                    "$ref": "#/definitions/casper.ContractPackageHash"
+                },
+                "deploy_hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "$ref": "#/definitions/entities.ReputationChangeReason"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "voting_id": {
                     "type": "integer"
+                }
+            }
+        },
+        "entities.ReputationChangeReason": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3,
+                4,
+                5,
+                6
+            ],
+            "x-enum-varnames": [
+                "ReputationChangeReasonMinted",
+                "ReputationChangeReasonBurned",
+                "ReputationChangeReasonStaked",
+                "ReputationChangeReasonVotingGained",
+                "ReputationChangeReasonVotingLost",
+                "ReputationChangeReasonUnstaked"
+            ]
+        },
+        "entities.Setting": {
+            "type": "object",
+            "properties": {
+                "activation_time": {
+                    "type": "string"
                 },
-                "proposed_time_frame": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 },
-                "reputation_stake": {
-                    "type": "integer"
+                "next_value": {
+                    "type": "string"
                 },
-                "timestamp": {
+                "value": {
                     "type": "string"
                 },
-                "worker": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "value_type_id": {
+                    "$ref": "#/definitions/entities.SettingValueTypeID"
                 }
             }
         },
-        "entities.Job": {
+        "entities.SettingChange": {
             "type": "object",
             "properties": {
-                "bid_id": {
-                    "type": "integer"
-                },
-                "caller": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "activation_time": {
+                    "type": "string"
                 },
                 "deploy_hash": {
                     "type": "array",
@@ -1428,40 +5132,126 @@ const docTemplate = `{
                         "type": "integer"
                     }
                 },
-                "finish_time": {
-                    "type": "integer"
+                "effective_at": {
+                    "type": "string"
                 },
-                "job_id": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 },
-                "job_poster": {
+                "setting_change_source_id": {
+                    "$ref": "#/definitions/entities.SettingChangeSourceID"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                },
+                "value_type_id": {
+                    "$ref": "#/definitions/entities.SettingValueTypeID"
+                }
+            }
+        },
+        "entities.SettingChangeSourceID": {
+            "type": "integer",
+            "enum": [
+                1,
+                2
+            ],
+            "x-enum-varnames": [
+                "SettingChangeSourceIDValueUpdated",
+                "SettingChangeSourceIDChainSync"
+            ]
+        },
+        "entities.SettingProposal": {
+            "type": "object",
+            "properties": {
+                "activation_time": {
+                    "type": "string"
+                },
+                "current_value": {
+                    "description": "CurrentValue is the value of the setting at the time of the request",
+                    "type": "string"
+                },
+                "deploy_hash": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "job_status_id": {
-                    "$ref": "#/definitions/entities.JobStatusID"
+                "name": {
+                    "type": "string"
                 },
-                "result": {
+                "proposed_value": {
+                    "type": "string"
+                },
+                "resolved_at": {
                     "type": "string"
                 },
+                "setting_proposal_status_id": {
+                    "$ref": "#/definitions/entities.SettingProposalStatusID"
+                },
                 "timestamp": {
                     "type": "string"
                 },
-                "worker": {
+                "value_type_id": {
+                    "$ref": "#/definitions/entities.SettingValueTypeID"
+                },
+                "variable_repo_to_edit": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
+                },
+                "voting_id": {
+                    "type": "integer"
                 }
             }
         },
-        "entities.JobOffer": {
+        "entities.SettingProposalStatusID": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3,
+                4
+            ],
+            "x-enum-varnames": [
+                "SettingProposalStatusIDPending",
+                "SettingProposalStatusIDAccepted",
+                "SettingProposalStatusIDRejected",
+                "SettingProposalStatusIDCanceled"
+            ]
+        },
+        "entities.SettingValueTypeID": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3,
+                4
+            ],
+            "x-enum-varnames": [
+                "SettingValueTypeIDU64",
+                "SettingValueTypeIDU256",
+                "SettingValueTypeIDAddress",
+                "SettingValueTypeIDBool"
+            ]
+        },
+        "entities.Slashing": {
             "type": "object",
             "properties": {
-                "auction_type_id": {
-                    "$ref": "#/definitions/entities.AuctionTypeID"
+                "address_to_slash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "creator": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
                 "deploy_hash": {
                     "type": "array",
@@ -1469,70 +5259,72 @@ const docTemplate = `{
                         "type": "integer"
                     }
                 },
-                "expected_time_frame": {
+                "reputation_burned": {
                     "type": "integer"
                 },
-                "job_offer_id": {
-                    "type": "integer"
+                "resolved_at": {
+                    "type": "string"
                 },
-                "job_poster": {
+                "resolved_deploy_hash": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "max_budget": {
+                "slash_ratio": {
+                    "type": "integer"
+                },
+                "slashing_status_id": {
+                    "$ref": "#/definitions/entities.SlashingStatusID"
+                },
+                "stakes_returned": {
                     "type": "integer"
                 },
                 "timestamp": {
                     "type": "string"
+                },
+                "voting_id": {
+                    "type": "integer"
                 }
             }
         },
-        "entities.JobStatusID": {
+        "entities.SlashingStatusID": {
             "type": "integer",
             "enum": [
                 1,
                 2,
                 3,
-                4,
-                5
+                4
             ],
             "x-enum-varnames": [
-                "JobStatusIDCreated",
-                "JobStatusIDSubmitted",
-                "JobStatusIDCancelled",
-                "JobStatusIDDone",
-                "JobStatusIDRejected"
+                "SlashingStatusIDPending",
+                "SlashingStatusIDSlashed",
+                "SlashingStatusIDRejected",
+                "SlashingStatusIDCanceled"
             ]
         },
-        "entities.ReputationChangeReason": {
+        "entities.StakeTypeID": {
             "type": "integer",
             "enum": [
                 1,
-                2,
-                3,
-                4,
-                5,
-                6
+                2
             ],
             "x-enum-varnames": [
-                "ReputationChangeReasonMinted",
-                "ReputationChangeReasonBurned",
-                "ReputationChangeReasonStaked",
-                "ReputationChangeReasonVotingGained",
-                "ReputationChangeReasonVotingLost",
-                "ReputationChangeReasonUnstaked"
+                "StakeTypeIDReputation",
+                "StakeTypeIDCSPR"
             ]
         },
-        "entities.Setting": {
+        "entities.TimeSeriesPoint": {
             "type": "object",
             "properties": {
-                "name": {
+                "period_start": {
                     "type": "string"
                 },
                 "value": {
-                    "type": "string"
+                    "type": "integer"
+                },
+                "voting_type_id": {
+                    "$ref": "#/definitions/entities.VotingTypeID"
                 }
             }
         },
@@ -1586,6 +5378,9 @@ const docTemplate = `{
                 "amount": {
                     "type": "integer"
                 },
+                "canceled_at": {
+                    "type": "string"
+                },
                 "deploy_hash": {
                     "type": "array",
                     "items": {
@@ -1601,6 +5396,19 @@ const docTemplate = `{
                 "is_in_favour": {
                     "type": "boolean"
                 },
+                "reputation_burned": {
+                    "type": "integer"
+                },
+                "reputation_earned": {
+                    "type": "integer"
+                },
+                "stake_returned": {
+                    "description": "outcome of the ballot, filled when the voting stage is ended",
+                    "type": "integer"
+                },
+                "stake_type_id": {
+                    "$ref": "#/definitions/entities.StakeTypeID"
+                },
                 "timestamp": {
                     "type": "string"
                 },
@@ -1677,6 +5485,115 @@ const docTemplate = `{
                 }
             }
         },
+        "entities.VotingParticipation": {
+            "type": "object",
+            "properties": {
+                "both_stages_voters_number": {
+                    "description": "voters of both stages who changed the side between the informal and formal voting",
+                    "type": "integer"
+                },
+                "formal_staked_reputation": {
+                    "type": "integer"
+                },
+                "formal_staked_reputation_percent": {
+                    "type": "number"
+                },
+                "formal_turnout_percent": {
+                    "type": "number"
+                },
+                "formal_votes_number": {
+                    "type": "integer"
+                },
+                "formal_voting_result": {
+                    "type": "integer"
+                },
+                "informal_staked_reputation": {
+                    "description": "total reputation is taken at the end of the stage",
+                    "type": "integer"
+                },
+                "informal_staked_reputation_percent": {
+                    "type": "number"
+                },
+                "informal_turnout_percent": {
+                    "type": "number"
+                },
+                "informal_votes_number": {
+                    "type": "integer"
+                },
+                "informal_voting_result": {
+                    "type": "integer"
+                },
+                "switch_rate_percent": {
+                    "type": "number"
+                },
+                "switched_voters_number": {
+                    "type": "integer"
+                },
+                "total_onboarded": {
+                    "type": "integer"
+                },
+                "voting_id": {
+                    "type": "integer"
+                },
+                "voting_type_id": {
+                    "$ref": "#/definitions/entities.VotingTypeID"
+                }
+            }
+        },
+        "entities.VotingProjection": {
+            "type": "object",
+            "properties": {
+                "formal_voting_ends_at": {
+                    "type": "string"
+                },
+                "formal_voting_starts_at": {
+                    "type": "string"
+                },
+                "is_formal": {
+                    "type": "boolean"
+                },
+                "is_quorum_reachable": {
+                    "description": "IsQuorumReachable is false when there are not enough onboarded VAs left to reach the quorum",
+                    "type": "boolean"
+                },
+                "is_time_between_votings_doubled": {
+                    "type": "boolean"
+                },
+                "quorum": {
+                    "type": "integer"
+                },
+                "result": {
+                    "description": "Result is one of the VotingResult values",
+                    "type": "integer"
+                },
+                "stake_against": {
+                    "type": "integer"
+                },
+                "stake_in_favor": {
+                    "type": "integer"
+                },
+                "stake_to_flip": {
+                    "description": "StakeToFlip is the reputation to be staked on the losing side to flip the stake majority",
+                    "type": "integer"
+                },
+                "total_onboarded": {
+                    "type": "integer"
+                },
+                "votes_against": {
+                    "type": "integer"
+                },
+                "votes_in_favor": {
+                    "type": "integer"
+                },
+                "votes_to_quorum": {
+                    "description": "VotesToQuorum is the number of ballots missing to reach the quorum",
+                    "type": "integer"
+                },
+                "voting_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "entities.VotingTypeID": {
             "type": "integer",
             "enum": [
@@ -1700,6 +5617,50 @@ const docTemplate = `{
                 "VotingTypeBidEscrow"
             ]
         },
+        "entities.VotingTypeParticipation": {
+            "type": "object",
+            "properties": {
+                "avg_formal_staked_reputation_percent": {
+                    "type": "number"
+                },
+                "avg_formal_turnout_percent": {
+                    "type": "number"
+                },
+                "avg_informal_staked_reputation_percent": {
+                    "type": "number"
+                },
+                "avg_informal_turnout_percent": {
+                    "type": "number"
+                },
+                "formal_ended_number": {
+                    "type": "integer"
+                },
+                "formal_quorum_missed_number": {
+                    "type": "integer"
+                },
+                "formal_quorum_missed_percent": {
+                    "type": "number"
+                },
+                "informal_ended_number": {
+                    "type": "integer"
+                },
+                "informal_quorum_missed_number": {
+                    "type": "integer"
+                },
+                "informal_quorum_missed_percent": {
+                    "type": "number"
+                },
+                "switch_rate_percent": {
+                    "type": "number"
+                },
+                "voting_type_id": {
+                    "$ref": "#/definitions/entities.VotingTypeID"
+                },
+                "votings_number": {
+                    "type": "integer"
+                }
+            }
+        },
         "http_response.ErrorResponse": {
             "type": "object",
             "properties": {
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+
+	"casper-dao-middleware/pkg/config"
+
+	"github.com/caarlos0/env/v6"
+	"go.uber.org/zap/zapcore"
+)
+
+type Env struct {
+	LogLevel zapcore.Level `env:"LOG_LEVEL" envDefault:"info"`
+	Rebuild  bool          `env:"REBUILD_ON_MISMATCH" envDefault:"false"`
+
+	NodeRPCURL *url.URL
+
+	DBConfig     config.DBConfig
+	DaoContracts config.DaoContracts
+}
+
+func (e *Env) Parse() error {
+	err := env.Parse(e)
+	if err != nil {
+		return err
+	}
+
+	e.NodeRPCURL, err = url.Parse(fmt.Sprintf("http://%s:%s/rpc", config.GetEnv("NODE_ADDRESS"),
+		config.GetEnv("NODE_RPC_PORT")))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
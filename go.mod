@@ -21,6 +21,7 @@ require (
 	github.com/swaggo/swag v1.16.1
 	go.uber.org/dig v1.15.0
 	go.uber.org/zap v1.23.0
+	golang.org/x/crypto v0.11.0
 )
 
 require (
@@ -39,7 +40,6 @@ require (
 	github.com/swaggo/files v0.0.0-20220610200504-28940afbdbfe // indirect
 	go.uber.org/atomic v1.7.0 // indirect
 	go.uber.org/multierr v1.6.0 // indirect
-	golang.org/x/crypto v0.11.0 // indirect
 	golang.org/x/net v0.12.0 // indirect
 	golang.org/x/sync v0.3.0 // indirect
 	golang.org/x/sys v0.10.0 // indirect
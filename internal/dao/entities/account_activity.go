@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+)
+
+// AccountActivityType is the discriminator of the AccountActivity, it tells which entity the EntityID refers to
+type AccountActivityType string
+
+const (
+	// AccountActivityTypeVoteCast refers to the Voting
+	AccountActivityTypeVoteCast AccountActivityType = "vote_cast"
+	// AccountActivityTypeBallotCanceled refers to the Voting
+	AccountActivityTypeBallotCanceled AccountActivityType = "ballot_canceled"
+	// AccountActivityTypeVotingCreated refers to the Voting
+	AccountActivityTypeVotingCreated AccountActivityType = "voting_created"
+	// AccountActivityTypeReputationChanged refers to the ReputationChange, stake and unstake legs are not included
+	AccountActivityTypeReputationChanged AccountActivityType = "reputation_changed"
+	// AccountActivityTypeKYCGranted has no EntityID
+	AccountActivityTypeKYCGranted AccountActivityType = "kyc_granted"
+	// AccountActivityTypeVAGranted has no EntityID
+	AccountActivityTypeVAGranted AccountActivityType = "va_granted"
+	// AccountActivityTypeKYCRevoked has no EntityID
+	AccountActivityTypeKYCRevoked AccountActivityType = "kyc_revoked"
+	// AccountActivityTypeVARevoked has no EntityID
+	AccountActivityTypeVARevoked AccountActivityType = "va_revoked"
+	// AccountActivityTypeJobOfferPosted refers to the JobOffer
+	AccountActivityTypeJobOfferPosted AccountActivityType = "job_offer_posted"
+	// AccountActivityTypeBidSubmitted refers to the Bid
+	AccountActivityTypeBidSubmitted AccountActivityType = "bid_submitted"
+	// AccountActivityTypeJobCreated and the rest of the job transitions refer to the Job
+	AccountActivityTypeJobCreated   AccountActivityType = "job_created"
+	AccountActivityTypeJobSubmitted AccountActivityType = "job_submitted"
+	AccountActivityTypeJobCancelled AccountActivityType = "job_cancelled"
+	AccountActivityTypeJobDone      AccountActivityType = "job_done"
+	AccountActivityTypeJobRejected  AccountActivityType = "job_rejected"
+)
+
+// AccountActivity is the item of the account timeline referring to the underlying entity by its ID
+type AccountActivity struct {
+	ActivityType AccountActivityType `json:"activity_type" db:"activity_type"`
+	EntityID     *uint64             `json:"entity_id" db:"entity_id"`
+	DeployHash   *casper.Hash        `json:"deploy_hash" db:"deploy_hash"`
+	Timestamp    time.Time           `json:"timestamp" db:"timestamp"`
+}
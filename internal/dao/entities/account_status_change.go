@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+)
+
+// AccountStatusChangeID describes which status is granted to the account by the NFT mint or revoked by the NFT burn
+type AccountStatusChangeID byte
+
+const (
+	AccountStatusChangeIDKYCGranted AccountStatusChangeID = iota + 1
+	AccountStatusChangeIDVAGranted
+	AccountStatusChangeIDKYCRevoked
+	AccountStatusChangeIDVARevoked
+)
+
+type AccountStatusChange struct {
+	Address               casper.Hash           `json:"address" db:"address"`
+	AccountStatusChangeID AccountStatusChangeID `json:"account_status_change_id" db:"account_status_change_id"`
+	DeployHash            *casper.Hash          `json:"deploy_hash" db:"deploy_hash"`
+	Timestamp             time.Time             `json:"timestamp" db:"timestamp"`
+}
+
+func NewAccountStatusChange(address casper.Hash, accountStatusChangeID AccountStatusChangeID, deployHash casper.Hash, timestamp time.Time) AccountStatusChange {
+	return AccountStatusChange{
+		Address:               address,
+		AccountStatusChangeID: accountStatusChangeID,
+		DeployHash:            &deployHash,
+		Timestamp:             timestamp,
+	}
+}
@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"github.com/make-software/casper-go-sdk/casper"
+)
+
+// JobTimeFrames compares time frames proposed in the bids with the time the workers really spent on the jobs, both in seconds
+type JobTimeFrames struct {
+	AvgProposedTimeFrame uint64 `json:"avg_proposed_time_frame" db:"avg_proposed_time_frame"`
+	AvgActualTimeFrame   uint64 `json:"avg_actual_time_frame" db:"avg_actual_time_frame"`
+}
+
+type BidEscrowStats struct {
+	Address casper.Hash `json:"address"`
+
+	// worker stats
+	JobsDone             uint64 `json:"jobs_done"`
+	JobsRejected         uint64 `json:"jobs_rejected"`
+	JobsCancelled        uint64 `json:"jobs_cancelled"`
+	AvgProposedTimeFrame uint64 `json:"avg_proposed_time_frame"`
+	AvgActualTimeFrame   uint64 `json:"avg_actual_time_frame"`
+	TotalProposedPayment uint64 `json:"total_proposed_payment"`
+	BidsSubmitted        uint64 `json:"bids_submitted"`
+	BidsWon              uint64 `json:"bids_won"`
+
+	// job poster stats
+	JobOffersPosted    uint64 `json:"job_offers_posted"`
+	JobOffersCompleted uint64 `json:"job_offers_completed"`
+}
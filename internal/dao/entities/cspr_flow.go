@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+)
+
+type CSPRFlowTypeID byte
+
+const (
+	CSPRFlowTypeIDPaymentDeposit CSPRFlowTypeID = iota + 1
+	CSPRFlowTypeIDStakeDeposit
+	CSPRFlowTypeIDWorkerPayout
+	CSPRFlowTypeIDDAOFee
+	CSPRFlowTypeIDRefund
+	CSPRFlowTypeIDForfeitedStake
+	CSPRFlowTypeIDStakeReturn
+)
+
+// CSPRFlow is a single movement of CSPR related to the BidEscrow job,
+// nil Sender or Recipient stands for the BidEscrow contract itself
+type CSPRFlow struct {
+	JobOfferID     uint32         `json:"job_offer_id" db:"job_offer_id"`
+	BidID          uint32         `json:"bid_id" db:"bid_id"`
+	JobID          *uint32        `json:"job_id" db:"job_id"`
+	CSPRFlowTypeID CSPRFlowTypeID `json:"cspr_flow_type_id" db:"cspr_flow_type_id"`
+	Sender         *casper.Hash   `json:"sender" db:"sender"`
+	Recipient      *casper.Hash   `json:"recipient" db:"recipient"`
+	Amount         uint64         `json:"amount" db:"amount"`
+	Sequence       uint16         `json:"-" db:"sequence"`
+	DeployHash     casper.Hash    `json:"deploy_hash" db:"deploy_hash"`
+	Timestamp      time.Time      `json:"timestamp" db:"timestamp"`
+}
+
+func NewCSPRFlow(
+	jobOfferID uint32,
+	bidID uint32,
+	jobID *uint32,
+	flowTypeID CSPRFlowTypeID,
+	sender, recipient *casper.Hash,
+	amount uint64,
+	deployHash casper.Hash,
+	timestamp time.Time) CSPRFlow {
+	return CSPRFlow{
+		JobOfferID:     jobOfferID,
+		BidID:          bidID,
+		JobID:          jobID,
+		CSPRFlowTypeID: flowTypeID,
+		Sender:         sender,
+		Recipient:      recipient,
+		Amount:         amount,
+		DeployHash:     deployHash,
+		Timestamp:      timestamp,
+	}
+}
@@ -16,6 +16,14 @@ const (
 	JobStatusIDRejected
 )
 
+// JobRole is the role of account in the Job
+type JobRole string
+
+const (
+	JobRolePoster JobRole = "poster"
+	JobRoleWorker JobRole = "worker"
+)
+
 type JobStatus struct {
 	ID   JobStatusID `json:"id"`
 	Name string      `json:"name"`
@@ -13,6 +13,16 @@ const (
 	AuctionTypeIDExternal
 )
 
+// JobOfferState is derived from the JobOffer bids and jobs
+type JobOfferState string
+
+const (
+	// JobOfferStateOpen JobOffer has no picked bid, so it is still open for bidding
+	JobOfferStateOpen JobOfferState = "open"
+	// JobOfferStateHasJob JobOffer bid was picked and Job created
+	JobOfferStateHasJob JobOfferState = "has_job"
+)
+
 type JobOffer struct {
 	JobOfferID        uint32        `json:"job_offer_id" db:"job_offer_id"`
 	JobPoster         casper.Hash   `json:"job_poster" db:"job_poster"`
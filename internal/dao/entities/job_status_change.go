@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+)
+
+type JobStatusChange struct {
+	JobID       uint32       `json:"job_id" db:"job_id"`
+	BidID       uint32       `json:"bid_id" db:"bid_id"`
+	JobStatusID JobStatusID  `json:"job_status_id" db:"job_status_id"`
+	Caller      *casper.Hash `json:"caller" db:"caller"`
+	Result      *string      `json:"result" db:"result"`
+	DeployHash  casper.Hash  `json:"deploy_hash" db:"deploy_hash"`
+	Timestamp   time.Time    `json:"timestamp" db:"timestamp"`
+	// IsRestored marks transitions restored from already tracked jobs, their timestamp is not the deploy time
+	IsRestored bool `json:"is_restored" db:"is_restored"`
+}
+
+func NewJobStatusChange(
+	jobID uint32,
+	bidID uint32,
+	jobStatusID JobStatusID,
+	caller *casper.Hash,
+	result *string,
+	deployHash casper.Hash,
+	timestamp time.Time) JobStatusChange {
+	return JobStatusChange{
+		JobID:       jobID,
+		BidID:       bidID,
+		JobStatusID: jobStatusID,
+		Caller:      caller,
+		Result:      result,
+		DeployHash:  deployHash,
+		Timestamp:   timestamp,
+	}
+}
@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+)
+
+type OnboardingRequestStatusID byte
+
+const (
+	OnboardingRequestStatusIDPending OnboardingRequestStatusID = iota + 1
+	OnboardingRequestStatusIDAccepted
+	OnboardingRequestStatusIDRejected
+	OnboardingRequestStatusIDCanceled
+)
+
+// DepositStatusID describes what happened with the CSPR deposit of the resolved OnboardingRequest
+type DepositStatusID byte
+
+const (
+	DepositStatusIDRefunded DepositStatusID = iota + 1
+	DepositStatusIDForfeited
+)
+
+type OnboardingRequest struct {
+	VotingID                  uint32                    `json:"voting_id" db:"voting_id"`
+	Requester                 casper.Hash               `json:"requester" db:"requester"`
+	Reason                    string                    `json:"reason" db:"reason"`
+	CSPRDeposit               uint64                    `json:"cspr_deposit" db:"cspr_deposit"`
+	OnboardingRequestStatusID OnboardingRequestStatusID `json:"onboarding_request_status_id" db:"onboarding_request_status_id"`
+	DepositStatusID           *DepositStatusID          `json:"deposit_status_id" db:"deposit_status_id"`
+	VATokenID                 *uint64                   `json:"va_token_id" db:"va_token_id"`
+	VAGrantedDeployHash       *casper.Hash              `json:"va_granted_deploy_hash" db:"va_granted_deploy_hash"`
+	DeployHash                casper.Hash               `json:"deploy_hash" db:"deploy_hash"`
+	Timestamp                 time.Time                 `json:"timestamp" db:"timestamp"`
+	ResolvedAt                *time.Time                `json:"resolved_at" db:"resolved_at"`
+}
+
+func NewOnboardingRequest(
+	votingID uint32,
+	requester casper.Hash,
+	reason string,
+	csprDeposit uint64,
+	deployHash casper.Hash,
+	timestamp time.Time) OnboardingRequest {
+	return OnboardingRequest{
+		VotingID:                  votingID,
+		Requester:                 requester,
+		Reason:                    reason,
+		CSPRDeposit:               csprDeposit,
+		OnboardingRequestStatusID: OnboardingRequestStatusIDPending,
+		DeployHash:                deployHash,
+		Timestamp:                 timestamp,
+	}
+}
@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"time"
+)
+
+// PendingActionTypeID describes what the account is expected to do before the deadline
+type PendingActionTypeID byte
+
+const (
+	// PendingActionTypeIDVote the VA has not voted in the active voting stage
+	PendingActionTypeIDVote PendingActionTypeID = iota + 1
+	// PendingActionTypeIDSubmitJob the worker has not submitted the job result
+	PendingActionTypeIDSubmitJob
+	// PendingActionTypeIDCancelJob the worker missed the job finish time, so the job poster can cancel the job
+	PendingActionTypeIDCancelJob
+)
+
+type PendingAction struct {
+	PendingActionTypeID PendingActionTypeID `json:"pending_action_type_id"`
+	VotingID            *uint32             `json:"voting_id"`
+	IsFormal            *bool               `json:"is_formal"`
+	JobID               *uint32             `json:"job_id"`
+	Deadline            time.Time           `json:"deadline"`
+}
+
+func NewVotePendingAction(votingID uint32, isFormal bool, deadline time.Time) PendingAction {
+	return PendingAction{
+		PendingActionTypeID: PendingActionTypeIDVote,
+		VotingID:            &votingID,
+		IsFormal:            &isFormal,
+		Deadline:            deadline,
+	}
+}
+
+func NewJobPendingAction(pendingActionTypeID PendingActionTypeID, jobID uint32, deadline time.Time) PendingAction {
+	return PendingAction{
+		PendingActionTypeID: pendingActionTypeID,
+		JobID:               &jobID,
+		Deadline:            deadline,
+	}
+}
@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+)
+
+type ReputationBalance struct {
+	Address             casper.Hash                `json:"address" db:"address"`
+	ContractPackageHash casper.ContractPackageHash `json:"contract_package_hash" db:"contract_package_hash"`
+	Amount              int64                      `json:"amount" db:"amount"`
+}
+
+type AggregatedReputationBalance struct {
+	Address          casper.Hash `json:"address" db:"address"`
+	LiquidReputation int64       `json:"liquid_reputation" db:"liquid_reputation"`
+	StakedReputation int64       `json:"staked_reputation" db:"staked_reputation"`
+	TotalReputation  int64       `json:"total_reputation" db:"total_reputation"`
+}
+
+// ReputationBalanceMismatch represents the difference between reputation_balances and the reputation_changes ledger
+type ReputationBalanceMismatch struct {
+	Address             casper.Hash                `json:"address" db:"address"`
+	ContractPackageHash casper.ContractPackageHash `json:"contract_package_hash" db:"contract_package_hash"`
+	BalanceAmount       int64                      `json:"balance_amount" db:"balance_amount"`
+	LedgerAmount        int64                      `json:"ledger_amount" db:"ledger_amount"`
+}
+
+type AccountReputation struct {
+	Address                 casper.Hash         `json:"address"`
+	AsOf                    time.Time           `json:"as_of"`
+	LiquidReputation        int64               `json:"liquid_reputation"`
+	StakedReputation        int64               `json:"staked_reputation"`
+	TotalReputation         int64               `json:"total_reputation"`
+	StakedByContractPackage []ReputationBalance `json:"staked_by_contract_package"`
+}
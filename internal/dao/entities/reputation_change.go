@@ -7,6 +7,7 @@ import (
 )
 
 type ReputationChange struct {
+	ID                  uint64                     `json:"id" db:"id"`
 	Address             casper.Hash                `json:"address" db:"address"`
 	ContractPackageHash casper.ContractPackageHash `json:"contract_package_hash" db:"contract_package_hash"`
 	VotingID            *uint32                    `json:"voting_id" db:"voting_id"`
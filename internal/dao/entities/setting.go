@@ -1,21 +1,88 @@
 package entities
 
 import (
+	"encoding/json"
 	"time"
 )
 
+// Setting is the governance variable of the Variable Repository, the scheduled value becomes current at the activation time
 type Setting struct {
-	Name           string     `json:"name" db:"name"`
-	Value          string     `json:"value" db:"value"`
-	NextValue      *string    `json:"-" db:"next_value"`
-	ActivationTime *time.Time `json:"-" db:"activation_time"`
+	Name           string              `json:"name" db:"name"`
+	Value          string              `json:"value" db:"value"`
+	ValueTypeID    *SettingValueTypeID `json:"value_type_id" db:"value_type_id"`
+	NextValue      *string             `json:"next_value" db:"next_value"`
+	ActivationTime *time.Time          `json:"activation_time" db:"activation_time"`
 }
 
-func NewSetting(name, value string, nextValue *string, activationTime *time.Time) Setting {
+func NewSetting(name, value string, valueTypeID *SettingValueTypeID, nextValue *string, activationTime *time.Time) Setting {
 	return Setting{
 		Name:           name,
 		Value:          value,
+		ValueTypeID:    valueTypeID,
 		NextValue:      nextValue,
 		ActivationTime: activationTime,
 	}
 }
+
+// ApplyPendingValue makes the scheduled value current if it is active at the provided time
+func (s *Setting) ApplyPendingValue(at time.Time) {
+	if s.NextValue == nil || s.ActivationTime == nil || s.ActivationTime.After(at) {
+		return
+	}
+
+	s.Value = *s.NextValue
+	s.NextValue = nil
+	s.ActivationTime = nil
+}
+
+// ApplyUpdate applies the value updated at the provided time, the value without activation time becomes current right away
+func (s *Setting) ApplyUpdate(value string, valueTypeID *SettingValueTypeID, activationTime *time.Time, at time.Time) {
+	s.ApplyPendingValue(at)
+
+	// the immediate update replaces the scheduled value as well
+	if activationTime == nil {
+		s.Value = value
+		s.NextValue = nil
+	} else {
+		s.NextValue = &value
+	}
+	s.ValueTypeID = valueTypeID
+	s.ActivationTime = activationTime
+}
+
+// MarshalJSON writes values decoded by their type along with the catalog definition of the setting
+func (s Setting) MarshalJSON() ([]byte, error) {
+	type setting Setting
+
+	var nextValue interface{}
+	if s.NextValue != nil {
+		nextValue = decodeSettingValue(s.ValueTypeID, *s.NextValue)
+	}
+
+	var definition *SettingDefinition
+	if settingDefinition, ok := GetSettingDefinition(s.Name); ok {
+		definition = &settingDefinition
+	}
+
+	return json.Marshal(struct {
+		setting
+		Value      interface{}        `json:"value"`
+		NextValue  interface{}        `json:"next_value"`
+		Definition *SettingDefinition `json:"definition"`
+	}{
+		setting:    setting(s),
+		Value:      decodeSettingValue(s.ValueTypeID, s.Value),
+		NextValue:  nextValue,
+		Definition: definition,
+	})
+}
+
+// SettingMismatch represents the difference between the stored setting and the Variable Repository state
+type SettingMismatch struct {
+	Name                string     `json:"name"`
+	StoredValue         *string    `json:"stored_value"`
+	StoredNextValue     *string    `json:"stored_next_value"`
+	ChainValue          string     `json:"chain_value"`
+	ChainNextValue      *string    `json:"chain_next_value"`
+	ChainActivationTime *time.Time `json:"chain_activation_time"`
+}
@@ -0,0 +1,149 @@
+package entities
+
+import "sort"
+
+type SettingUnit string
+
+const (
+	SettingUnitMilliseconds SettingUnit = "milliseconds"
+	SettingUnitPerMille     SettingUnit = "per_mille"
+	SettingUnitMotes        SettingUnit = "motes"
+	SettingUnitFiat         SettingUnit = "fiat"
+	SettingUnitAddress      SettingUnit = "address"
+	SettingUnitFlag         SettingUnit = "flag"
+	SettingUnitNumber       SettingUnit = "number"
+)
+
+// SettingDefinition describes the meaning of the Variable Repository variable
+type SettingDefinition struct {
+	Description string      `json:"description"`
+	Unit        SettingUnit `json:"unit"`
+}
+
+var settingCatalog = map[string]SettingDefinition{
+	"PostJobDOSFee": {
+		Description: "Minimal DOS fee paid by the job poster, converted to CSPR by the fiat conversion rate",
+		Unit:        SettingUnitFiat,
+	},
+	"InternalAuctionTime": {
+		Description: "Duration of the job offer auction open for VAs only",
+		Unit:        SettingUnitMilliseconds,
+	},
+	"PublicAuctionTime": {
+		Description: "Duration of the job offer auction open for everyone after the internal one",
+		Unit:        SettingUnitMilliseconds,
+	},
+	"DefaultPolicingRate": {
+		Description: "Share of the job payment redistributed to the VAs",
+		Unit:        SettingUnitPerMille,
+	},
+	"ReputationConversionRate": {
+		Description: "Share of the job payment converted to the reputation of the worker",
+		Unit:        SettingUnitPerMille,
+	},
+	"FiatConversionRateAddress": {
+		Description: "Address of the contract providing CSPR to fiat conversion rate",
+		Unit:        SettingUnitAddress,
+	},
+	"ForumKycRequired": {
+		Description: "Whether KYC is required to post on the forum",
+		Unit:        SettingUnitFlag,
+	},
+	"BidEscrowInformalQuorumRatio": {
+		Description: "Quorum of the BidEscrow informal voting",
+		Unit:        SettingUnitPerMille,
+	},
+	"BidEscrowFormalQuorumRatio": {
+		Description: "Quorum of the BidEscrow formal voting",
+		Unit:        SettingUnitPerMille,
+	},
+	"InformalQuorumRatio": {
+		Description: "Quorum of the governance informal voting",
+		Unit:        SettingUnitPerMille,
+	},
+	"FormalQuorumRatio": {
+		Description: "Quorum of the governance formal voting",
+		Unit:        SettingUnitPerMille,
+	},
+	"BidEscrowInformalVotingTime": {
+		Description: "Duration of the BidEscrow informal voting",
+		Unit:        SettingUnitMilliseconds,
+	},
+	"BidEscrowFormalVotingTime": {
+		Description: "Duration of the BidEscrow formal voting",
+		Unit:        SettingUnitMilliseconds,
+	},
+	"InformalVotingTime": {
+		Description: "Duration of the governance informal voting",
+		Unit:        SettingUnitMilliseconds,
+	},
+	"FormalVotingTime": {
+		Description: "Duration of the governance formal voting",
+		Unit:        SettingUnitMilliseconds,
+	},
+	"InformalStakeReputation": {
+		Description: "Whether the reputation is staked in the informal voting",
+		Unit:        SettingUnitFlag,
+	},
+	"TimeBetweenInformalAndFormalVoting": {
+		Description: "Delay between the end of the informal voting and the start of the formal one",
+		Unit:        SettingUnitMilliseconds,
+	},
+	"VABidAcceptanceTimeout": {
+		Description: "Time the VA bidder has to accept the job after the bid was picked",
+		Unit:        SettingUnitMilliseconds,
+	},
+	"VACanBidOnPublicAuction": {
+		Description: "Whether VAs can bid on the public auction",
+		Unit:        SettingUnitFlag,
+	},
+	"DistributePaymentToNonVoters": {
+		Description: "Whether the job payment is redistributed to the VAs who did not vote",
+		Unit:        SettingUnitFlag,
+	},
+	"BidEscrowWalletAddress": {
+		Description: "Address of the wallet receiving the BidEscrow DAO fee",
+		Unit:        SettingUnitAddress,
+	},
+	"BidEscrowPaymentRatio": {
+		Description: "Share of the job payment paid to the BidEscrow wallet",
+		Unit:        SettingUnitPerMille,
+	},
+	"DefaultReputationSlash": {
+		Description: "Share of the worker reputation slashed when the job is rejected",
+		Unit:        SettingUnitPerMille,
+	},
+	"VotingClearnessDelta": {
+		Description: "Result difference below which the time between informal and formal voting is doubled",
+		Unit:        SettingUnitPerMille,
+	},
+	"VotingStartAfterJobSubmission": {
+		Description: "Delay between the job submission and the start of the BidEscrow voting",
+		Unit:        SettingUnitMilliseconds,
+	},
+	"CancelFinishedVotingTimeout": {
+		Description: "Time after the voting end when the unfinished voting can be canceled",
+		Unit:        SettingUnitMilliseconds,
+	},
+	"VotingIdsAddress": {
+		Description: "Address of the contract generating voting ids",
+		Unit:        SettingUnitAddress,
+	},
+}
+
+// GetSettingDefinition returns the catalog description of the Variable Repository variable
+func GetSettingDefinition(name string) (SettingDefinition, bool) {
+	definition, ok := settingCatalog[name]
+	return definition, ok
+}
+
+// GetSettingNames returns sorted names of the Variable Repository variables known by the catalog
+func GetSettingNames() []string {
+	names := make([]string, 0, len(settingCatalog))
+	for name := range settingCatalog {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+)
+
+// SettingChangeSourceID describes where the SettingChange was taken from
+type SettingChangeSourceID byte
+
+const (
+	SettingChangeSourceIDValueUpdated SettingChangeSourceID = iota + 1
+	SettingChangeSourceIDChainSync
+)
+
+// SettingChange is the Variable Repository value update, EffectiveAt is the time the value becomes current,
+// changes recorded by the settings sync have no deploy and are timestamped with the sync time
+type SettingChange struct {
+	Name                  string                `json:"name" db:"name"`
+	Value                 string                `json:"value" db:"value"`
+	ValueTypeID           *SettingValueTypeID   `json:"value_type_id" db:"value_type_id"`
+	ActivationTime        *time.Time            `json:"activation_time" db:"activation_time"`
+	EffectiveAt           time.Time             `json:"effective_at" db:"effective_at"`
+	SettingChangeSourceID SettingChangeSourceID `json:"setting_change_source_id" db:"setting_change_source_id"`
+	DeployHash            *casper.Hash          `json:"deploy_hash" db:"deploy_hash"`
+	Timestamp             time.Time             `json:"timestamp" db:"timestamp"`
+}
+
+func NewSettingChange(
+	name, value string,
+	valueTypeID *SettingValueTypeID,
+	activationTime *time.Time,
+	deployHash casper.Hash,
+	timestamp time.Time) SettingChange {
+	effectiveAt := timestamp
+	if activationTime != nil {
+		effectiveAt = *activationTime
+	}
+
+	return SettingChange{
+		Name:                  name,
+		Value:                 value,
+		ValueTypeID:           valueTypeID,
+		ActivationTime:        activationTime,
+		EffectiveAt:           effectiveAt,
+		SettingChangeSourceID: SettingChangeSourceIDValueUpdated,
+		DeployHash:            &deployHash,
+		Timestamp:             timestamp,
+	}
+}
+
+func NewSyncedSettingChange(
+	name, value string,
+	valueTypeID *SettingValueTypeID,
+	activationTime *time.Time,
+	timestamp time.Time) SettingChange {
+	effectiveAt := timestamp
+	if activationTime != nil {
+		effectiveAt = *activationTime
+	}
+
+	return SettingChange{
+		Name:                  name,
+		Value:                 value,
+		ValueTypeID:           valueTypeID,
+		ActivationTime:        activationTime,
+		EffectiveAt:           effectiveAt,
+		SettingChangeSourceID: SettingChangeSourceIDChainSync,
+		Timestamp:             timestamp,
+	}
+}
+
+// MarshalJSON writes the value decoded by its type
+func (s SettingChange) MarshalJSON() ([]byte, error) {
+	type settingChange SettingChange
+
+	return json.Marshal(struct {
+		settingChange
+		Value interface{} `json:"value"`
+	}{
+		settingChange: settingChange(s),
+		Value:         decodeSettingValue(s.ValueTypeID, s.Value),
+	})
+}
@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+)
+
+type SettingProposalStatusID byte
+
+const (
+	SettingProposalStatusIDPending SettingProposalStatusID = iota + 1
+	SettingProposalStatusIDAccepted
+	SettingProposalStatusIDRejected
+	SettingProposalStatusIDCanceled
+)
+
+// SettingProposal is the change of the Variable Repository variable proposed by the Repo Voter voting
+type SettingProposal struct {
+	VotingID                uint32                  `json:"voting_id" db:"voting_id"`
+	Name                    string                  `json:"name" db:"name"`
+	VariableRepoToEdit      casper.Hash             `json:"variable_repo_to_edit" db:"variable_repo_to_edit"`
+	ProposedValue           *string                 `json:"proposed_value" db:"proposed_value"`
+	ValueTypeID             *SettingValueTypeID     `json:"value_type_id" db:"value_type_id"`
+	ActivationTime          *time.Time              `json:"activation_time" db:"activation_time"`
+	SettingProposalStatusID SettingProposalStatusID `json:"setting_proposal_status_id" db:"setting_proposal_status_id"`
+	DeployHash              casper.Hash             `json:"deploy_hash" db:"deploy_hash"`
+	Timestamp               time.Time               `json:"timestamp" db:"timestamp"`
+	ResolvedAt              *time.Time              `json:"resolved_at" db:"resolved_at"`
+	// CurrentValue is the value of the setting at the time of the request
+	CurrentValue *string `json:"current_value" db:"-"`
+}
+
+func NewSettingProposal(
+	votingID uint32,
+	name string,
+	variableRepoToEdit casper.Hash,
+	proposedValue *string,
+	valueTypeID *SettingValueTypeID,
+	activationTime *time.Time,
+	deployHash casper.Hash,
+	timestamp time.Time) SettingProposal {
+	return SettingProposal{
+		VotingID:                votingID,
+		Name:                    name,
+		VariableRepoToEdit:      variableRepoToEdit,
+		ProposedValue:           proposedValue,
+		ValueTypeID:             valueTypeID,
+		ActivationTime:          activationTime,
+		SettingProposalStatusID: SettingProposalStatusIDPending,
+		DeployHash:              deployHash,
+		Timestamp:               timestamp,
+	}
+}
+
+// MarshalJSON writes the proposed and current values decoded by the setting type along with the setting definition
+func (p SettingProposal) MarshalJSON() ([]byte, error) {
+	type settingProposal SettingProposal
+
+	var proposedValue, currentValue interface{}
+	if p.ProposedValue != nil {
+		proposedValue = decodeSettingValue(p.ValueTypeID, *p.ProposedValue)
+	}
+	if p.CurrentValue != nil {
+		currentValue = decodeSettingValue(p.ValueTypeID, *p.CurrentValue)
+	}
+
+	var definition *SettingDefinition
+	if settingDefinition, ok := GetSettingDefinition(p.Name); ok {
+		definition = &settingDefinition
+	}
+
+	return json.Marshal(struct {
+		settingProposal
+		ProposedValue interface{}        `json:"proposed_value"`
+		CurrentValue  interface{}        `json:"current_value"`
+		Definition    *SettingDefinition `json:"definition"`
+	}{
+		settingProposal: settingProposal(p),
+		ProposedValue:   proposedValue,
+		CurrentValue:    currentValue,
+		Definition:      definition,
+	})
+}
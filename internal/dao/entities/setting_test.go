@@ -0,0 +1,80 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettingApplyPendingValue(t *testing.T) {
+	activationTime := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	newPendingSetting := func() Setting {
+		nextValue := "20"
+		return NewSetting("PostJobDOSFee", "10", nil, &nextValue, &activationTime)
+	}
+
+	t.Run("not active yet", func(t *testing.T) {
+		setting := newPendingSetting()
+		setting.ApplyPendingValue(activationTime.Add(-time.Second))
+
+		assert.Equal(t, "10", setting.Value)
+		assert.Equal(t, "20", *setting.NextValue)
+		assert.Equal(t, activationTime, *setting.ActivationTime)
+	})
+
+	t.Run("active at the activation time", func(t *testing.T) {
+		setting := newPendingSetting()
+		setting.ApplyPendingValue(activationTime)
+
+		assert.Equal(t, "20", setting.Value)
+		assert.Nil(t, setting.NextValue)
+		assert.Nil(t, setting.ActivationTime)
+	})
+
+	t.Run("no pending value", func(t *testing.T) {
+		setting := NewSetting("PostJobDOSFee", "10", nil, nil, nil)
+		setting.ApplyPendingValue(activationTime)
+
+		assert.Equal(t, "10", setting.Value)
+		assert.Nil(t, setting.NextValue)
+		assert.Nil(t, setting.ActivationTime)
+	})
+}
+
+func TestSettingApplyUpdate(t *testing.T) {
+	activationTime := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	newPendingSetting := func() Setting {
+		nextValue := "20"
+		return NewSetting("PostJobDOSFee", "10", nil, &nextValue, &activationTime)
+	}
+
+	t.Run("immediate update replaces the scheduled value", func(t *testing.T) {
+		setting := newPendingSetting()
+		setting.ApplyUpdate("30", nil, nil, activationTime.Add(-time.Hour))
+
+		assert.Equal(t, "30", setting.Value)
+		assert.Nil(t, setting.NextValue)
+		assert.Nil(t, setting.ActivationTime)
+	})
+
+	t.Run("scheduled update keeps the current value", func(t *testing.T) {
+		setting := newPendingSetting()
+		nextActivationTime := activationTime.Add(time.Hour)
+		setting.ApplyUpdate("30", nil, &nextActivationTime, activationTime.Add(-time.Hour))
+
+		assert.Equal(t, "10", setting.Value)
+		assert.Equal(t, "30", *setting.NextValue)
+		assert.Equal(t, nextActivationTime, *setting.ActivationTime)
+	})
+
+	t.Run("scheduled update applies the active pending value first", func(t *testing.T) {
+		setting := newPendingSetting()
+		nextActivationTime := activationTime.Add(2 * time.Hour)
+		setting.ApplyUpdate("30", nil, &nextActivationTime, activationTime.Add(time.Hour))
+
+		assert.Equal(t, "20", setting.Value)
+		assert.Equal(t, "30", *setting.NextValue)
+		assert.Equal(t, nextActivationTime, *setting.ActivationTime)
+	})
+}
@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"casper-dao-middleware/internal/dao/types"
+)
+
+// SettingValueTypeID is the type of the value stored in the Variable Repository record
+type SettingValueTypeID byte
+
+const (
+	SettingValueTypeIDU64 SettingValueTypeID = iota + 1
+	SettingValueTypeIDU256
+	SettingValueTypeIDAddress
+	SettingValueTypeIDBool
+)
+
+func NewSettingValueTypeID(value types.RecordValue) *SettingValueTypeID {
+	var typeID SettingValueTypeID
+
+	switch {
+	case value.U64Value != nil:
+		typeID = SettingValueTypeIDU64
+	case value.UValue != nil:
+		typeID = SettingValueTypeIDU256
+	case value.Address != nil:
+		typeID = SettingValueTypeIDAddress
+	case value.BoolValue != nil:
+		typeID = SettingValueTypeIDBool
+	default:
+		return nil
+	}
+
+	return &typeID
+}
+
+// decodeSettingValue converts the stored string value to the JSON value of its type,
+// U256 values are kept as strings as they do not fit JSON numbers
+func decodeSettingValue(typeID *SettingValueTypeID, value string) interface{} {
+	if typeID == nil {
+		return value
+	}
+
+	switch *typeID {
+	case SettingValueTypeIDU64:
+		if _, err := strconv.ParseUint(value, 10, 64); err == nil {
+			return json.Number(value)
+		}
+	case SettingValueTypeIDBool:
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSettingValue(t *testing.T) {
+	typeID := func(id SettingValueTypeID) *SettingValueTypeID {
+		return &id
+	}
+
+	tests := []struct {
+		name     string
+		typeID   *SettingValueTypeID
+		value    string
+		expected interface{}
+	}{
+		{"unknown type", nil, "100", "100"},
+		{"u64", typeID(SettingValueTypeIDU64), "18446744073709551615", json.Number("18446744073709551615")},
+		{"invalid u64", typeID(SettingValueTypeIDU64), "18446744073709551616", "18446744073709551616"},
+		{"u256 is kept as string", typeID(SettingValueTypeIDU256), "340282366920938463463374607431768211456", "340282366920938463463374607431768211456"},
+		{"address", typeID(SettingValueTypeIDAddress), "account-hash-0101", "account-hash-0101"},
+		{"bool", typeID(SettingValueTypeIDBool), "true", true},
+		{"invalid bool", typeID(SettingValueTypeIDBool), "yes", "yes"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, decodeSettingValue(test.typeID, test.value))
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+)
+
+type SlashingStatusID byte
+
+const (
+	SlashingStatusIDPending SlashingStatusID = iota + 1
+	SlashingStatusIDSlashed
+	SlashingStatusIDRejected
+	SlashingStatusIDCanceled
+)
+
+// Slashing is the disciplinary procedure started by the Slashing Voter against the VA
+type Slashing struct {
+	VotingID           uint32           `json:"voting_id" db:"voting_id"`
+	AddressToSlash     casper.Hash      `json:"address_to_slash" db:"address_to_slash"`
+	SlashRatio         uint32           `json:"slash_ratio" db:"slash_ratio"`
+	Creator            casper.Hash      `json:"creator" db:"creator"`
+	SlashingStatusID   SlashingStatusID `json:"slashing_status_id" db:"slashing_status_id"`
+	ReputationBurned   *uint64          `json:"reputation_burned" db:"reputation_burned"`
+	StakesReturned     *uint64          `json:"stakes_returned" db:"stakes_returned"`
+	DeployHash         casper.Hash      `json:"deploy_hash" db:"deploy_hash"`
+	ResolvedDeployHash *casper.Hash     `json:"resolved_deploy_hash" db:"resolved_deploy_hash"`
+	Timestamp          time.Time        `json:"timestamp" db:"timestamp"`
+	ResolvedAt         *time.Time       `json:"resolved_at" db:"resolved_at"`
+}
+
+func NewSlashing(
+	votingID uint32,
+	addressToSlash casper.Hash,
+	slashRatio uint32,
+	creator casper.Hash,
+	deployHash casper.Hash,
+	timestamp time.Time) Slashing {
+	return Slashing{
+		VotingID:         votingID,
+		AddressToSlash:   addressToSlash,
+		SlashRatio:       slashRatio,
+		Creator:          creator,
+		SlashingStatusID: SlashingStatusIDPending,
+		DeployHash:       deployHash,
+		Timestamp:        timestamp,
+	}
+}
@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"time"
+)
+
+// TimeSeriesMetric is the DAO-wide value aggregated per TimeSeriesInterval
+type TimeSeriesMetric string
+
+const (
+	// TimeSeriesMetricVotingsCreated is grouped by the VotingTypeID
+	TimeSeriesMetricVotingsCreated   TimeSeriesMetric = "votings_created"
+	TimeSeriesMetricBallotsCast      TimeSeriesMetric = "ballots_cast"
+	TimeSeriesMetricUniqueVoters     TimeSeriesMetric = "unique_voters"
+	TimeSeriesMetricReputationMinted TimeSeriesMetric = "reputation_minted"
+	TimeSeriesMetricReputationBurned TimeSeriesMetric = "reputation_burned"
+	TimeSeriesMetricNewVAs           TimeSeriesMetric = "new_vas"
+	TimeSeriesMetricNewKYCAccounts   TimeSeriesMetric = "new_kyc_accounts"
+	TimeSeriesMetricJobOffersPosted  TimeSeriesMetric = "job_offers_posted"
+	TimeSeriesMetricJobsCompleted    TimeSeriesMetric = "jobs_completed"
+)
+
+var timeSeriesMetrics = []TimeSeriesMetric{
+	TimeSeriesMetricVotingsCreated,
+	TimeSeriesMetricBallotsCast,
+	TimeSeriesMetricUniqueVoters,
+	TimeSeriesMetricReputationMinted,
+	TimeSeriesMetricReputationBurned,
+	TimeSeriesMetricNewVAs,
+	TimeSeriesMetricNewKYCAccounts,
+	TimeSeriesMetricJobOffersPosted,
+	TimeSeriesMetricJobsCompleted,
+}
+
+func (m TimeSeriesMetric) IsValid() bool {
+	for _, metric := range timeSeriesMetrics {
+		if metric == m {
+			return true
+		}
+	}
+	return false
+}
+
+// TimeSeriesInterval is the length of the period the metric is aggregated for, weeks start on Monday
+type TimeSeriesInterval string
+
+const (
+	TimeSeriesIntervalDay   TimeSeriesInterval = "day"
+	TimeSeriesIntervalWeek  TimeSeriesInterval = "week"
+	TimeSeriesIntervalMonth TimeSeriesInterval = "month"
+)
+
+func (i TimeSeriesInterval) IsValid() bool {
+	return i == TimeSeriesIntervalDay || i == TimeSeriesIntervalWeek || i == TimeSeriesIntervalMonth
+}
+
+// TimeSeriesPoint is the metric value of the period, periods without values are omitted
+type TimeSeriesPoint struct {
+	PeriodStart  time.Time     `json:"period_start" db:"period_start"`
+	VotingTypeID *VotingTypeID `json:"voting_type_id,omitempty" db:"voting_type_id"`
+	Value        uint64        `json:"value" db:"value"`
+}
@@ -6,25 +6,66 @@ import (
 	"github.com/make-software/casper-go-sdk/casper"
 )
 
+// StakeTypeID describes what is staked in the ballot, Non VA voters in BidEscrow stake CSPR instead of reputation
+type StakeTypeID byte
+
+const (
+	StakeTypeIDReputation StakeTypeID = iota + 1
+	StakeTypeIDCSPR
+)
+
 type Vote struct {
-	Address    casper.Hash `json:"address" db:"address"`
-	VotingID   uint32      `json:"voting_id" db:"voting_id"`
-	Amount     uint64      `json:"amount" db:"amount"`
-	IsInFavor  bool        `json:"is_in_favour" db:"is_in_favour"`
-	IsCanceled bool        `json:"is_canceled" db:"is_canceled"`
-	IsFormal   bool        `json:"is_formal" db:"is_formal"`
-	DeployHash casper.Hash `json:"deploy_hash" db:"deploy_hash"`
-	Timestamp  time.Time   `json:"timestamp" db:"timestamp"`
+	Address     casper.Hash `json:"address" db:"address"`
+	VotingID    uint32      `json:"voting_id" db:"voting_id"`
+	Amount      uint64      `json:"amount" db:"amount"`
+	StakeTypeID StakeTypeID `json:"stake_type_id" db:"stake_type_id"`
+	IsInFavor   bool        `json:"is_in_favour" db:"is_in_favour"`
+	IsCanceled  bool        `json:"is_canceled" db:"is_canceled"`
+	CanceledAt  *time.Time  `json:"canceled_at" db:"canceled_at"`
+	IsFormal    bool        `json:"is_formal" db:"is_formal"`
+	// outcome of the ballot, filled when the voting stage is ended
+	StakeReturned    *uint64     `json:"stake_returned" db:"stake_returned"`
+	ReputationEarned *uint64     `json:"reputation_earned" db:"reputation_earned"`
+	ReputationBurned *uint64     `json:"reputation_burned" db:"reputation_burned"`
+	DeployHash       casper.Hash `json:"deploy_hash" db:"deploy_hash"`
+	Timestamp        time.Time   `json:"timestamp" db:"timestamp"`
+}
+
+// VoteOutcome represents the result of the ballot from the VotingEnded Unstakes, Mints and Burns maps
+type VoteOutcome struct {
+	Address          casper.Hash
+	StakeReturned    uint64
+	ReputationEarned uint64
+	ReputationBurned uint64
+}
+
+// VotingStakeTally is the sum of not canceled ballots of the voting stage staked with the same StakeTypeID
+type VotingStakeTally struct {
+	VotingID      uint32      `json:"-" db:"voting_id"`
+	IsFormal      bool        `json:"is_formal" db:"is_formal"`
+	StakeTypeID   StakeTypeID `json:"stake_type_id" db:"stake_type_id"`
+	InFavorAmount uint64      `json:"in_favor_amount" db:"in_favor_amount"`
+	AgainstAmount uint64      `json:"against_amount" db:"against_amount"`
+	VotesNumber   uint32      `json:"votes_number" db:"votes_number"`
+}
+
+// VotingStageTally is the number of not canceled ballots and the reputation staked on each side of the voting stage
+type VotingStageTally struct {
+	VotesInFavor uint32 `json:"votes_in_favor" db:"votes_in_favor"`
+	VotesAgainst uint32 `json:"votes_against" db:"votes_against"`
+	StakeInFavor uint64 `json:"stake_in_favor" db:"stake_in_favor"`
+	StakeAgainst uint64 `json:"stake_against" db:"stake_against"`
 }
 
-func NewVote(address, deployHash casper.Hash, votingID uint32, staked uint64, isInFavor bool, isFormal bool, timestamp time.Time) *Vote {
+func NewVote(address, deployHash casper.Hash, votingID uint32, staked uint64, stakeTypeID StakeTypeID, isInFavor bool, isFormal bool, timestamp time.Time) *Vote {
 	return &Vote{
-		Address:    address,
-		VotingID:   votingID,
-		Amount:     staked,
-		DeployHash: deployHash,
-		IsInFavor:  isInFavor,
-		IsFormal:   isFormal,
-		Timestamp:  timestamp,
+		Address:     address,
+		VotingID:    votingID,
+		Amount:      staked,
+		StakeTypeID: stakeTypeID,
+		DeployHash:  deployHash,
+		IsInFavor:   isInFavor,
+		IsFormal:    isFormal,
+		Timestamp:   timestamp,
 	}
 }
@@ -2,9 +2,20 @@ package entities
 
 import (
 	"encoding/json"
+	"math"
 	"time"
 
 	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/utils"
+)
+
+// VotingResult values of the VotingEnded event
+const (
+	VotingResultInFavor uint8 = iota
+	VotingResultAgainst
+	VotingResultQuorumNotReached
+	VotingResultCanceled
 )
 
 type Voting struct {
@@ -59,3 +70,32 @@ func NewVoting(
 		ConfigTimeBetweenInformalAndFormalVoting: configTimeBetweenInformalAndFormalVoting,
 	}
 }
+
+// CalculateFormalVotingPeriod returns the time when the formal voting starts and ends after the informal voting with the given votes.
+//
+// This behavior is configured using VotingClearnessDelta Governance Variable.
+// It is a numeric value which tells how far from 50/50 result can be in percent points, before the time will be doubled.
+// For example, when VotingClearnessDelta is set to 8 and the result of the Informal Voting is 42 percent "for" and 58 "against" then the time between votings should be doubled.
+// When the result is 41/59, the default value of time will be used.
+func (v Voting) CalculateFormalVotingPeriod(votesInFavor, votesAgainst uint32) (time.Time, time.Time) {
+	timeBetweenVotings := v.ConfigTimeBetweenInformalAndFormalVoting
+	if !v.IsTimeBetweenVotingsDefault(votesInFavor, votesAgainst) {
+		timeBetweenVotings *= 2
+	}
+
+	formalStartsAt := v.InformalVotingEndsAt.Add(time.Millisecond * time.Duration(timeBetweenVotings))
+	formalEndsAt := formalStartsAt.Add(time.Millisecond * time.Duration(v.FormalVotingTime))
+	return formalStartsAt, formalEndsAt
+}
+
+// IsTimeBetweenVotingsDefault reports whether the informal voting votes are clear enough to keep the default time between votings,
+// the result without votes is not clear
+func (v Voting) IsTimeBetweenVotingsDefault(votesInFavor, votesAgainst uint32) bool {
+	totalVotes := uint64(votesInFavor) + uint64(votesAgainst)
+	if totalVotes == 0 {
+		return false
+	}
+
+	inFavourPercent := utils.PercentOf(uint64(votesInFavor), totalVotes)
+	return math.Abs(50-inFavourPercent) > float64(v.ConfigVotingClearnessDelta)
+}
@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// VotingConfig is the configuration of the Voting from the VotingCreated event
+// along with the snapshot of the governance settings effective at the Voting creation
+type VotingConfig struct {
+	VotingID                           uint32          `json:"voting_id" db:"voting_id"`
+	InformalQuorum                     uint32          `json:"informal_quorum" db:"informal_quorum"`
+	InformalVotingTime                 uint64          `json:"informal_voting_time" db:"informal_voting_time"`
+	FormalQuorum                       uint32          `json:"formal_quorum" db:"formal_quorum"`
+	FormalVotingTime                   uint64          `json:"formal_voting_time" db:"formal_voting_time"`
+	TotalOnboarded                     uint64          `json:"total_onboarded" db:"total_onboarded"`
+	DoubleTimeBetweenVotings           *bool           `json:"double_time_between_votings" db:"double_time_between_votings"`
+	VotingClearnessDelta               uint64          `json:"voting_clearness_delta" db:"voting_clearness_delta"`
+	TimeBetweenInformalAndFormalVoting uint64          `json:"time_between_informal_and_formal_voting" db:"time_between_informal_and_formal_voting"`
+	Settings                           json.RawMessage `json:"settings" db:"settings"`
+	Timestamp                          time.Time       `json:"timestamp" db:"timestamp"`
+}
+
+func NewVotingConfig(
+	votingID uint32,
+	informalQuorum uint32,
+	informalVotingTime uint64,
+	formalQuorum uint32,
+	formalVotingTime uint64,
+	totalOnboarded uint64,
+	doubleTimeBetweenVotings bool,
+	votingClearnessDelta uint64,
+	timeBetweenInformalAndFormalVoting uint64,
+	timestamp time.Time) VotingConfig {
+	return VotingConfig{
+		VotingID:                           votingID,
+		InformalQuorum:                     informalQuorum,
+		InformalVotingTime:                 informalVotingTime,
+		FormalQuorum:                       formalQuorum,
+		FormalVotingTime:                   formalVotingTime,
+		TotalOnboarded:                     totalOnboarded,
+		DoubleTimeBetweenVotings:           &doubleTimeBetweenVotings,
+		VotingClearnessDelta:               votingClearnessDelta,
+		TimeBetweenInformalAndFormalVoting: timeBetweenInformalAndFormalVoting,
+		Timestamp:                          timestamp,
+	}
+}
+
+// NewSettingsSnapshot builds the JSON object of the setting values decoded by their types
+func NewSettingsSnapshot(settings []Setting) (json.RawMessage, error) {
+	snapshot := make(map[string]interface{}, len(settings))
+	for _, setting := range settings {
+		snapshot[setting.Name] = decodeSettingValue(setting.ValueTypeID, setting.Value)
+	}
+
+	return json.Marshal(snapshot)
+}
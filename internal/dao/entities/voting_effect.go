@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+)
+
+type VotingEffectTypeID byte
+
+const (
+	VotingEffectTypeIDReputationMinted VotingEffectTypeID = iota + 1
+	VotingEffectTypeIDReputationBurned
+	VotingEffectTypeIDVAGranted
+	VotingEffectTypeIDVARevoked
+	VotingEffectTypeIDKYCGranted
+	VotingEffectTypeIDKYCRevoked
+)
+
+// VotingEffect is the on-chain action executed by the passed governance Voting in the VotingEnded deploy
+type VotingEffect struct {
+	VotingID           uint32             `json:"voting_id" db:"voting_id"`
+	VotingEffectTypeID VotingEffectTypeID `json:"voting_effect_type_id" db:"voting_effect_type_id"`
+	Address            casper.Hash        `json:"address" db:"address"`
+	Amount             *uint64            `json:"amount" db:"amount"`
+	TokenID            *uint64            `json:"token_id" db:"token_id"`
+	DeployHash         casper.Hash        `json:"deploy_hash" db:"deploy_hash"`
+	TransformID        uint               `json:"-" db:"transform_id"`
+	Timestamp          time.Time          `json:"timestamp" db:"timestamp"`
+}
+
+func NewVotingEffect(
+	votingID uint32,
+	votingEffectTypeID VotingEffectTypeID,
+	address casper.Hash,
+	amount, tokenID *uint64,
+	deployHash casper.Hash,
+	transformID uint,
+	timestamp time.Time) VotingEffect {
+	return VotingEffect{
+		VotingID:           votingID,
+		VotingEffectTypeID: votingEffectTypeID,
+		Address:            address,
+		Amount:             amount,
+		TokenID:            tokenID,
+		DeployHash:         deployHash,
+		TransformID:        transformID,
+		Timestamp:          timestamp,
+	}
+}
@@ -0,0 +1,47 @@
+package entities
+
+// VotingParticipation describes how actively the onboarded VAs took part in the Voting stages,
+// percents are nil when the base value is zero
+type VotingParticipation struct {
+	VotingID             uint32       `json:"voting_id" db:"voting_id"`
+	VotingTypeID         VotingTypeID `json:"voting_type_id" db:"voting_type_id"`
+	TotalOnboarded       uint64       `json:"total_onboarded" db:"total_onboarded"`
+	InformalVotingResult *uint8       `json:"informal_voting_result" db:"informal_voting_result"`
+	FormalVotingResult   *uint8       `json:"formal_voting_result" db:"formal_voting_result"`
+
+	InformalVotesNumber    uint32   `json:"informal_votes_number" db:"informal_votes_number"`
+	FormalVotesNumber      uint32   `json:"formal_votes_number" db:"formal_votes_number"`
+	InformalTurnoutPercent *float64 `json:"informal_turnout_percent" db:"informal_turnout_percent"`
+	FormalTurnoutPercent   *float64 `json:"formal_turnout_percent" db:"formal_turnout_percent"`
+
+	// total reputation is taken at the end of the stage
+	InformalStakedReputation        uint64   `json:"informal_staked_reputation" db:"informal_staked_reputation"`
+	FormalStakedReputation          uint64   `json:"formal_staked_reputation" db:"formal_staked_reputation"`
+	InformalStakedReputationPercent *float64 `json:"informal_staked_reputation_percent" db:"informal_staked_reputation_percent"`
+	FormalStakedReputationPercent   *float64 `json:"formal_staked_reputation_percent" db:"formal_staked_reputation_percent"`
+
+	// voters of both stages who changed the side between the informal and formal voting
+	BothStagesVotersNumber uint32   `json:"both_stages_voters_number" db:"both_stages_voters_number"`
+	SwitchedVotersNumber   uint32   `json:"switched_voters_number" db:"switched_voters_number"`
+	SwitchRatePercent      *float64 `json:"switch_rate_percent" db:"switch_rate_percent"`
+}
+
+// VotingTypeParticipation aggregates VotingParticipation of not canceled Votings of the same type,
+// averages are calculated over the ended stages only
+type VotingTypeParticipation struct {
+	VotingTypeID  VotingTypeID `json:"voting_type_id" db:"voting_type_id"`
+	VotingsNumber uint32       `json:"votings_number" db:"votings_number"`
+
+	InformalEndedNumber         uint32   `json:"informal_ended_number" db:"informal_ended_number"`
+	InformalQuorumMissedNumber  uint32   `json:"informal_quorum_missed_number" db:"informal_quorum_missed_number"`
+	InformalQuorumMissedPercent *float64 `json:"informal_quorum_missed_percent" db:"informal_quorum_missed_percent"`
+	FormalEndedNumber           uint32   `json:"formal_ended_number" db:"formal_ended_number"`
+	FormalQuorumMissedNumber    uint32   `json:"formal_quorum_missed_number" db:"formal_quorum_missed_number"`
+	FormalQuorumMissedPercent   *float64 `json:"formal_quorum_missed_percent" db:"formal_quorum_missed_percent"`
+
+	AvgInformalTurnoutPercent          *float64 `json:"avg_informal_turnout_percent" db:"avg_informal_turnout_percent"`
+	AvgFormalTurnoutPercent            *float64 `json:"avg_formal_turnout_percent" db:"avg_formal_turnout_percent"`
+	AvgInformalStakedReputationPercent *float64 `json:"avg_informal_staked_reputation_percent" db:"avg_informal_staked_reputation_percent"`
+	AvgFormalStakedReputationPercent   *float64 `json:"avg_formal_staked_reputation_percent" db:"avg_formal_staked_reputation_percent"`
+	SwitchRatePercent                  *float64 `json:"switch_rate_percent" db:"switch_rate_percent"`
+}
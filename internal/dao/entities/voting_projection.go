@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"time"
+)
+
+// VotingProjection is the likely outcome of the current Voting stage by the contract rules applied to the current ballots
+type VotingProjection struct {
+	VotingID       uint32 `json:"voting_id"`
+	IsFormal       bool   `json:"is_formal"`
+	Quorum         uint32 `json:"quorum"`
+	TotalOnboarded uint64 `json:"total_onboarded"`
+	VotingStageTally
+	// Result is one of the VotingResult values
+	Result uint8 `json:"result"`
+	// VotesToQuorum is the number of ballots missing to reach the quorum
+	VotesToQuorum uint32 `json:"votes_to_quorum"`
+	// IsQuorumReachable is false when there are not enough onboarded VAs left to reach the quorum
+	IsQuorumReachable bool `json:"is_quorum_reachable"`
+	// StakeToFlip is the reputation to be staked on the losing side to flip the stake majority
+	StakeToFlip                 uint64     `json:"stake_to_flip"`
+	IsTimeBetweenVotingsDoubled bool       `json:"is_time_between_votings_doubled"`
+	FormalVotingStartsAt        *time.Time `json:"formal_voting_starts_at"`
+	FormalVotingEndsAt          *time.Time `json:"formal_voting_ends_at"`
+}
@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVotingIsTimeBetweenVotingsDefault(t *testing.T) {
+	voting := Voting{
+		ConfigVotingClearnessDelta: 8,
+	}
+
+	tests := []struct {
+		name         string
+		votesInFavor uint32
+		votesAgainst uint32
+		expected     bool
+	}{
+		{"clear result against", 41, 59, true},
+		{"unclear result against", 42, 58, false},
+		{"clear result in favor", 59, 41, true},
+		{"unclear result in favor", 58, 42, false},
+		{"even result", 50, 50, false},
+		{"unanimous result", 10, 0, true},
+		{"no votes", 0, 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, voting.IsTimeBetweenVotingsDefault(test.votesInFavor, test.votesAgainst))
+		})
+	}
+}
+
+func TestVotingCalculateFormalVotingPeriod(t *testing.T) {
+	informalVotingEndsAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	voting := Voting{
+		InformalVotingEndsAt:                     informalVotingEndsAt,
+		FormalVotingTime:                         uint64(time.Hour.Milliseconds()),
+		ConfigVotingClearnessDelta:               8,
+		ConfigTimeBetweenInformalAndFormalVoting: uint64(time.Minute.Milliseconds()),
+	}
+
+	t.Run("default time between votings", func(t *testing.T) {
+		startsAt, endsAt := voting.CalculateFormalVotingPeriod(70, 30)
+
+		assert.Equal(t, informalVotingEndsAt.Add(time.Minute), startsAt)
+		assert.Equal(t, informalVotingEndsAt.Add(time.Minute+time.Hour), endsAt)
+	})
+
+	t.Run("doubled time between votings", func(t *testing.T) {
+		startsAt, endsAt := voting.CalculateFormalVotingPeriod(45, 55)
+
+		assert.Equal(t, informalVotingEndsAt.Add(2*time.Minute), startsAt)
+		assert.Equal(t, informalVotingEndsAt.Add(2*time.Minute+time.Hour), endsAt)
+	})
+}
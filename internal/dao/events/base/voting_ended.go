@@ -89,7 +89,7 @@ func ParseVotingEndedEvent(event ces.Event) (VotingEndedEvent, error) {
 	if !ok || val.Type != cltype.UInt32 {
 		return VotingEndedEvent{}, errors.New("invalid votes_against value in event")
 	}
-	votingEnded.VotesInFavor = val.UI32.Value()
+	votingEnded.VotesAgainst = val.UI32.Value()
 
 	val, ok = event.Data["unstakes"]
 	if val.Map == nil {
@@ -12,18 +12,31 @@ import (
 // EntityManager main persistence interface
 type EntityManager interface {
 	ReputationChangeRepository() repositories.ReputationChange
+	ReputationBalanceRepository() repositories.ReputationBalance
 	TotalReputationSnapshotRepository() repositories.TotalReputationSnapshot
 	VoteRepository() repositories.Vote
 	VotingRepository() repositories.Voting
 	JobOfferRepository() repositories.JobOffer
 	BidRepository() repositories.Bid
 	JobRepository() repositories.Job
+	JobStatusChangeRepository() repositories.JobStatusChange
+	CSPRFlowRepository() repositories.CSPRFlow
+	OnboardingRequestRepository() repositories.OnboardingRequest
+	VotingEffectRepository() repositories.VotingEffect
+	SlashingRepository() repositories.Slashing
+	SettingChangeRepository() repositories.SettingChange
+	SettingProposalRepository() repositories.SettingProposal
+	VotingConfigRepository() repositories.VotingConfig
+	AccountStatusChangeRepository() repositories.AccountStatusChange
+	AccountActivityRepository() repositories.AccountActivity
+	StatsRepository() repositories.Stats
 	SettingRepository() repositories.Setting
 	AccountRepository() repositories.Account
 }
 
 type entityManager struct {
 	reputationChangesRepo       repositories.ReputationChange
+	reputationBalanceRepo       repositories.ReputationBalance
 	totalReputationSnapshotRepo repositories.TotalReputationSnapshot
 	voteRepository              repositories.Vote
 	votingRepository            repositories.Voting
@@ -32,11 +45,23 @@ type entityManager struct {
 	jobOfferRepo                repositories.JobOffer
 	bidRepo                     repositories.Bid
 	jobRepo                     repositories.Job
+	jobStatusChangeRepo         repositories.JobStatusChange
+	csprFlowRepo                repositories.CSPRFlow
+	onboardingRequestRepo       repositories.OnboardingRequest
+	votingEffectRepo            repositories.VotingEffect
+	slashingRepo                repositories.Slashing
+	settingChangeRepo           repositories.SettingChange
+	settingProposalRepo         repositories.SettingProposal
+	votingConfigRepo            repositories.VotingConfig
+	accountStatusChangeRepo     repositories.AccountStatusChange
+	accountActivityRepo         repositories.AccountActivity
+	statsRepo                   repositories.Stats
 }
 
 func NewEntityManager(db *sqlx.DB, hashes utils.DAOContractsMetadata) EntityManager {
 	return &entityManager{
 		reputationChangesRepo:       repositories.NewReputationChange(db, hashes),
+		reputationBalanceRepo:       repositories.NewReputationBalance(db, hashes),
 		totalReputationSnapshotRepo: repositories.NewTotalReputationSnapshot(db),
 		voteRepository:              repositories.NewVote(db),
 		votingRepository:            repositories.NewVoting(db),
@@ -45,6 +70,17 @@ func NewEntityManager(db *sqlx.DB, hashes utils.DAOContractsMetadata) EntityMana
 		jobOfferRepo:                repositories.NewJobOffer(db),
 		bidRepo:                     repositories.NewBid(db),
 		jobRepo:                     repositories.NewJob(db),
+		jobStatusChangeRepo:         repositories.NewJobStatusChange(db),
+		csprFlowRepo:                repositories.NewCSPRFlow(db),
+		onboardingRequestRepo:       repositories.NewOnboardingRequest(db),
+		votingEffectRepo:            repositories.NewVotingEffect(db),
+		slashingRepo:                repositories.NewSlashing(db),
+		settingChangeRepo:           repositories.NewSettingChange(db),
+		settingProposalRepo:         repositories.NewSettingProposal(db),
+		votingConfigRepo:            repositories.NewVotingConfig(db),
+		accountStatusChangeRepo:     repositories.NewAccountStatusChange(db),
+		accountActivityRepo:         repositories.NewAccountActivity(db),
+		statsRepo:                   repositories.NewStats(db),
 	}
 }
 
@@ -52,6 +88,10 @@ func (e entityManager) ReputationChangeRepository() repositories.ReputationChang
 	return e.reputationChangesRepo
 }
 
+func (e entityManager) ReputationBalanceRepository() repositories.ReputationBalance {
+	return e.reputationBalanceRepo
+}
+
 func (e entityManager) VoteRepository() repositories.Vote {
 	return e.voteRepository
 }
@@ -83,3 +123,47 @@ func (e entityManager) BidRepository() repositories.Bid {
 func (e entityManager) JobRepository() repositories.Job {
 	return e.jobRepo
 }
+
+func (e entityManager) JobStatusChangeRepository() repositories.JobStatusChange {
+	return e.jobStatusChangeRepo
+}
+
+func (e entityManager) CSPRFlowRepository() repositories.CSPRFlow {
+	return e.csprFlowRepo
+}
+
+func (e entityManager) OnboardingRequestRepository() repositories.OnboardingRequest {
+	return e.onboardingRequestRepo
+}
+
+func (e entityManager) VotingEffectRepository() repositories.VotingEffect {
+	return e.votingEffectRepo
+}
+
+func (e entityManager) SlashingRepository() repositories.Slashing {
+	return e.slashingRepo
+}
+
+func (e entityManager) SettingChangeRepository() repositories.SettingChange {
+	return e.settingChangeRepo
+}
+
+func (e entityManager) SettingProposalRepository() repositories.SettingProposal {
+	return e.settingProposalRepo
+}
+
+func (e entityManager) VotingConfigRepository() repositories.VotingConfig {
+	return e.votingConfigRepo
+}
+
+func (e entityManager) AccountStatusChangeRepository() repositories.AccountStatusChange {
+	return e.accountStatusChangeRepo
+}
+
+func (e entityManager) AccountActivityRepository() repositories.AccountActivity {
+	return e.accountActivityRepo
+}
+
+func (e entityManager) StatsRepository() repositories.Stats {
+	return e.statsRepo
+}
@@ -0,0 +1,132 @@
+package repositories
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/query"
+)
+
+// AccountActivity DB interface of the account timeline merged from the votes, votings, reputation_changes,
+// account_status_changes, job_offers, bids and job_status_changes tables
+//
+//go:generate mockgen -destination=../tests/mocks/account_activity_mock.go -package=mocks -source=./account_activity.go AccountActivity
+type AccountActivity interface {
+	Count(address casper.Hash) (uint64, error)
+	Find(params *pagination.Params, address casper.Hash) ([]entities.AccountActivity, error)
+}
+
+type accountActivity struct {
+	conn          *sqlx.DB
+	indexedFields map[string]struct{}
+}
+
+func NewAccountActivity(conn *sqlx.DB) AccountActivity {
+	return &accountActivity{
+		conn: conn,
+		indexedFields: map[string]struct{}{
+			"timestamp": {},
+		},
+	}
+}
+
+func (r *accountActivity) Count(address casper.Hash) (uint64, error) {
+	unionSQL, args, err := r.unionBuilder(address).ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(fmt.Sprintf("SELECT COUNT(*) FROM (%s) activities", unionSQL), args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *accountActivity) Find(params *pagination.Params, address casper.Hash) ([]entities.AccountActivity, error) {
+	sql, args, err := r.unionBuilder(address).
+		Paginate(params, r.indexedFields).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	activities := make([]entities.AccountActivity, 0)
+	if err := r.conn.Select(&activities, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return activities, nil
+}
+
+// unionBuilder selects activity_type, entity_id, deploy_hash and timestamp columns from every source table
+func (r *accountActivity) unionBuilder(address casper.Hash) query.UnionBuilder {
+	activityType := func(activityType entities.AccountActivityType) string {
+		return fmt.Sprintf("'%s' as activity_type", activityType)
+	}
+
+	votesCast := sq.Select(activityType(entities.AccountActivityTypeVoteCast), "voting_id as entity_id", "deploy_hash", "timestamp").
+		From("votes").
+		Where(sq.Eq{"address": address})
+
+	// ballot cancellation deploy is not stored
+	ballotsCanceled := sq.Select(activityType(entities.AccountActivityTypeBallotCanceled), "voting_id", "NULL", "canceled_at").
+		From("votes").
+		Where(sq.Eq{"address": address}).
+		Where(sq.NotEq{"canceled_at": nil})
+
+	votingsCreated := sq.Select(activityType(entities.AccountActivityTypeVotingCreated), "voting_id", "deploy_hash", "informal_voting_starts_at").
+		From("votings").
+		Where(sq.Eq{"creator": address})
+
+	// stake and unstake only move the reputation between the contracts, and the ballot is already the vote_cast item
+	reputationChanges := sq.Select(activityType(entities.AccountActivityTypeReputationChanged), "id", "deploy_hash", "timestamp").
+		From("reputation_changes").
+		Where(sq.Eq{"address": address}).
+		Where(sq.NotEq{"reason": []entities.ReputationChangeReason{entities.ReputationChangeReasonStaked, entities.ReputationChangeReasonUnstaked}})
+
+	statusChanges := sq.Select(
+		fmt.Sprintf("CASE account_status_change_id WHEN %d THEN '%s' WHEN %d THEN '%s' WHEN %d THEN '%s' ELSE '%s' END",
+			entities.AccountStatusChangeIDKYCGranted, entities.AccountActivityTypeKYCGranted,
+			entities.AccountStatusChangeIDVAGranted, entities.AccountActivityTypeVAGranted,
+			entities.AccountStatusChangeIDKYCRevoked, entities.AccountActivityTypeKYCRevoked,
+			entities.AccountActivityTypeVARevoked),
+		"NULL", "deploy_hash", "timestamp").
+		From("account_status_changes").
+		Where(sq.Eq{"address": address})
+
+	jobOffersPosted := sq.Select(activityType(entities.AccountActivityTypeJobOfferPosted), "job_offer_id", "deploy_hash", "timestamp").
+		From("job_offers").
+		Where(sq.Eq{"job_poster": address})
+
+	bidsSubmitted := sq.Select(activityType(entities.AccountActivityTypeBidSubmitted), "bid_id", "deploy_hash", "timestamp").
+		From("bids").
+		Where(sq.Eq{"worker": address})
+
+	jobTransitions := sq.Select(
+		fmt.Sprintf("CASE job_status_changes.job_status_id WHEN %d THEN '%s' WHEN %d THEN '%s' WHEN %d THEN '%s' WHEN %d THEN '%s' ELSE '%s' END",
+			entities.JobStatusIDCreated, entities.AccountActivityTypeJobCreated,
+			entities.JobStatusIDSubmitted, entities.AccountActivityTypeJobSubmitted,
+			entities.JobStatusIDCancelled, entities.AccountActivityTypeJobCancelled,
+			entities.JobStatusIDDone, entities.AccountActivityTypeJobDone,
+			entities.AccountActivityTypeJobRejected),
+		"job_status_changes.job_id", "job_status_changes.deploy_hash", "job_status_changes.timestamp").
+		From("job_status_changes").
+		Join("jobs ON jobs.job_id = job_status_changes.job_id").
+		Where(sq.Or{sq.Eq{"jobs.job_poster": address}, sq.Eq{"jobs.worker": address}})
+
+	return query.UnionAll(votesCast, ballotsCanceled).
+		UnionAll(votingsCreated).
+		UnionAll(reputationChanges).
+		UnionAll(statusChanges).
+		UnionAll(jobOffersPosted).
+		UnionAll(bidsSubmitted).
+		UnionAll(jobTransitions)
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/query"
+)
+
+// AccountStatusChange DB table interface
+//
+//go:generate mockgen -destination=../tests/mocks/account_status_change_mock.go -package=mocks -source=./account_status_change.go AccountStatusChange
+type AccountStatusChange interface {
+	Save(change *entities.AccountStatusChange) error
+}
+
+type accountStatusChange struct {
+	conn *sqlx.DB
+}
+
+func NewAccountStatusChange(conn *sqlx.DB) AccountStatusChange {
+	return &accountStatusChange{
+		conn: conn,
+	}
+}
+
+func (r *accountStatusChange) Save(change *entities.AccountStatusChange) error {
+	queryBuilder := query.Insert("account_status_changes").
+		Options("IGNORE").
+		Columns(
+			"address",
+			"account_status_change_id",
+			"deploy_hash",
+			"timestamp",
+		).
+		Values(
+			change.Address,
+			change.AccountStatusChangeID,
+			change.DeployHash,
+			change.Timestamp,
+		)
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -1,10 +1,14 @@
 package repositories
 
 import (
+	"database/sql"
+
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/make-software/casper-go-sdk/casper"
 
 	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/errors"
 	"casper-dao-middleware/pkg/pagination"
 	"casper-dao-middleware/pkg/query"
 )
@@ -14,8 +18,12 @@ import (
 //go:generate mockgen -destination=../tests/mocks/bid_mock.go -package=mocks -source=./bid.go Bid
 type Bid interface {
 	Save(bid *entities.Bid) error
+	GetByID(bidID uint32) (*entities.Bid, error)
 	Count(filters map[string]interface{}) (uint64, error)
 	Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.Bid, error)
+	CountByAccount(address casper.Hash, filters map[string]interface{}) (uint64, error)
+	FindByAccount(params *pagination.Params, address casper.Hash, filters map[string]interface{}) ([]*entities.Bid, error)
+	SumProposedPaymentByAccount(address casper.Hash) (uint64, error)
 	UpdateIsPickedBy(bidID uint32, isPickedBy bool) error
 }
 
@@ -28,7 +36,11 @@ func NewBid(conn *sqlx.DB) *bid {
 	return &bid{
 		conn: conn,
 		indexedFields: map[string]struct{}{
-			"job_offer_id": {},
+			"job_offer_id":         {},
+			"bid_id":               {},
+			"worker":               {},
+			"picked_by_job_poster": {},
+			"timestamp":            {},
 		},
 	}
 }
@@ -75,6 +87,29 @@ func (r *bid) Save(bid *entities.Bid) error {
 	return nil
 }
 
+func (r *bid) GetByID(bidID uint32) (*entities.Bid, error) {
+	queryBuilder := query.Select("*").
+		From("bids").
+		Where(sq.Eq{
+			"bid_id": bidID,
+		})
+
+	sqlQuery, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	bid := entities.Bid{}
+	if err := r.conn.Get(&bid, sqlQuery, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("not found bid by bid_id")
+		}
+		return nil, err
+	}
+
+	return &bid, nil
+}
+
 func (r *bid) Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.Bid, error) {
 	queryBuilder := query.Select("*").
 		From("bids").
@@ -135,3 +170,66 @@ func (r *bid) UpdateIsPickedBy(bidID uint32, isPickedBy bool) error {
 	}
 	return nil
 }
+
+// CountByAccount counts Bids submitted by the account
+func (r *bid) CountByAccount(address casper.Hash, filters map[string]interface{}) (uint64, error) {
+	queryBuilder := query.Select("COUNT(*)").
+		From("bids").
+		Where(sq.Eq{"worker": address}).
+		FilterBy(filters, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// FindByAccount finds Bids submitted by the account
+func (r *bid) FindByAccount(params *pagination.Params, address casper.Hash, filters map[string]interface{}) ([]*entities.Bid, error) {
+	queryBuilder := query.Select("*").
+		From("bids").
+		Where(sq.Eq{"worker": address}).
+		FilterBy(filters, r.indexedFields).
+		Paginate(params, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	bids := make([]*entities.Bid, 0)
+	if err := r.conn.Select(&bids, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return bids, nil
+}
+
+// SumProposedPaymentByAccount sums payments proposed in the Bids of the account which were picked and became Jobs
+func (r *bid) SumProposedPaymentByAccount(address casper.Hash) (uint64, error) {
+	queryBuilder := query.Select("CAST(COALESCE(SUM(bids.proposed_payment), 0) AS UNSIGNED)").
+		From("bids").
+		Join("jobs ON jobs.bid_id = bids.bid_id").
+		Where(sq.Eq{"bids.worker": address})
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var sum uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&sum); err != nil {
+		return 0, err
+	}
+	return sum, nil
+}
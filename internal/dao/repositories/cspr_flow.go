@@ -0,0 +1,158 @@
+package repositories
+
+import (
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/query"
+)
+
+// CSPRFlow DB table interface
+//
+//go:generate mockgen -destination=../tests/mocks/cspr_flow_mock.go -package=mocks -source=./cspr_flow.go CSPRFlow
+type CSPRFlow interface {
+	SaveBatch(flows []entities.CSPRFlow) error
+	Count(filters map[string]interface{}) (uint64, error)
+	Find(params *pagination.Params, filters map[string]interface{}) ([]entities.CSPRFlow, error)
+	CountByAccount(address casper.Hash, filters map[string]interface{}) (uint64, error)
+	FindByAccount(params *pagination.Params, address casper.Hash, filters map[string]interface{}) ([]entities.CSPRFlow, error)
+}
+
+type csprFlow struct {
+	conn          *sqlx.DB
+	indexedFields map[string]struct{}
+}
+
+func NewCSPRFlow(conn *sqlx.DB) CSPRFlow {
+	return &csprFlow{
+		conn: conn,
+		indexedFields: map[string]struct{}{
+			"job_offer_id":      {},
+			"bid_id":            {},
+			"job_id":            {},
+			"cspr_flow_type_id": {},
+			"amount":            {},
+			"timestamp":         {},
+		},
+	}
+}
+
+// SaveBatch stores the flows ignoring already tracked ones, flows of the same type within one deploy
+// are distinguished by their position in the batch
+func (r *csprFlow) SaveBatch(flows []entities.CSPRFlow) error {
+	if len(flows) == 0 {
+		return nil
+	}
+
+	columns := []string{
+		"job_offer_id",
+		"bid_id",
+		"job_id",
+		"cspr_flow_type_id",
+		"sender",
+		"recipient",
+		"amount",
+		"sequence",
+		"deploy_hash",
+		"timestamp",
+	}
+
+	sequences := make(map[entities.CSPRFlowTypeID]uint16)
+	for i := range flows {
+		flows[i].Sequence = sequences[flows[i].CSPRFlowTypeID]
+		sequences[flows[i].CSPRFlowTypeID]++
+	}
+
+	insertQuery := `INSERT IGNORE INTO cspr_flows (` + strings.Join(columns, ",") + `)
+		VALUES (:` + strings.Join(columns, ",:") + `)`
+
+	_, err := r.conn.NamedExec(insertQuery, flows)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *csprFlow) Count(filters map[string]interface{}) (uint64, error) {
+	queryBuilder := r.filterByTimeRange(query.Select("COUNT(*)").
+		From("cspr_flows").
+		FilterBy(filters, r.indexedFields), filters)
+
+	return r.count(queryBuilder)
+}
+
+func (r *csprFlow) Find(params *pagination.Params, filters map[string]interface{}) ([]entities.CSPRFlow, error) {
+	queryBuilder := r.filterByTimeRange(query.Select("*").
+		From("cspr_flows").
+		FilterBy(filters, r.indexedFields), filters).
+		Paginate(params, r.indexedFields)
+
+	return r.find(queryBuilder)
+}
+
+// CountByAccount counts flows sent or received by the account
+func (r *csprFlow) CountByAccount(address casper.Hash, filters map[string]interface{}) (uint64, error) {
+	queryBuilder := r.filterByTimeRange(query.Select("COUNT(*)").
+		From("cspr_flows").
+		Where(sq.Or{sq.Eq{"sender": address}, sq.Eq{"recipient": address}}).
+		FilterBy(filters, r.indexedFields), filters)
+
+	return r.count(queryBuilder)
+}
+
+// FindByAccount finds flows sent or received by the account
+func (r *csprFlow) FindByAccount(params *pagination.Params, address casper.Hash, filters map[string]interface{}) ([]entities.CSPRFlow, error) {
+	queryBuilder := r.filterByTimeRange(query.Select("*").
+		From("cspr_flows").
+		Where(sq.Or{sq.Eq{"sender": address}, sq.Eq{"recipient": address}}).
+		FilterBy(filters, r.indexedFields), filters).
+		Paginate(params, r.indexedFields)
+
+	return r.find(queryBuilder)
+}
+
+func (r *csprFlow) count(queryBuilder *query.SelectBuilder) (uint64, error) {
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *csprFlow) find(queryBuilder *query.SelectBuilder) ([]entities.CSPRFlow, error) {
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	flows := make([]entities.CSPRFlow, 0)
+	if err := r.conn.Select(&flows, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return flows, nil
+}
+
+func (r *csprFlow) filterByTimeRange(queryBuilder *query.SelectBuilder, filters map[string]interface{}) *query.SelectBuilder {
+	if from, ok := filters["from"].(time.Time); ok {
+		queryBuilder = queryBuilder.Where(sq.GtOrEq{"timestamp": from})
+	}
+	if to, ok := filters["to"].(time.Time); ok {
+		queryBuilder = queryBuilder.Where(sq.LtOrEq{"timestamp": to})
+	}
+	return queryBuilder
+}
@@ -5,9 +5,11 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/make-software/casper-go-sdk/casper"
 
 	"casper-dao-middleware/internal/dao/entities"
 	"casper-dao-middleware/pkg/errors"
+	"casper-dao-middleware/pkg/pagination"
 	"casper-dao-middleware/pkg/query"
 )
 
@@ -19,6 +21,11 @@ type Job interface {
 	GetByBidID(bidID uint32) (*entities.Job, error)
 	GetByID(jobID uint32) (*entities.Job, error)
 	Update(job *entities.Job) error
+	Count(filters map[string]interface{}) (uint64, error)
+	Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.Job, error)
+	CountByAccount(address casper.Hash, role *entities.JobRole, filters map[string]interface{}) (uint64, error)
+	FindByAccount(params *pagination.Params, address casper.Hash, role *entities.JobRole, filters map[string]interface{}) ([]*entities.Job, error)
+	CalculateWorkerTimeFrames(worker casper.Hash) (entities.JobTimeFrames, error)
 }
 
 type job struct {
@@ -28,8 +35,15 @@ type job struct {
 
 func NewJob(conn *sqlx.DB) *job {
 	return &job{
-		conn:          conn,
-		indexedFields: map[string]struct{}{},
+		conn: conn,
+		indexedFields: map[string]struct{}{
+			"job_id":        {},
+			"bid_id":        {},
+			"job_poster":    {},
+			"worker":        {},
+			"job_status_id": {},
+			"timestamp":     {},
+		},
 	}
 }
 
@@ -142,3 +156,118 @@ func (r job) Update(job *entities.Job) error {
 	}
 	return nil
 }
+
+func (r job) Count(filters map[string]interface{}) (uint64, error) {
+	queryBuilder := query.Select("COUNT(*)").
+		From("jobs").
+		FilterBy(filters, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r job) Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.Job, error) {
+	queryBuilder := query.Select("*").
+		From("jobs").
+		FilterBy(filters, r.indexedFields).
+		Paginate(params, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*entities.Job, 0)
+	if err := r.conn.Select(&jobs, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// CountByAccount counts Jobs where the account is the job poster or the worker, or any of them if role is not provided
+func (r job) CountByAccount(address casper.Hash, role *entities.JobRole, filters map[string]interface{}) (uint64, error) {
+	queryBuilder := query.Select("COUNT(*)").
+		From("jobs").
+		Where(r.accountRolePredicate(address, role)).
+		FilterBy(filters, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// FindByAccount finds Jobs where the account is the job poster or the worker, or any of them if role is not provided
+func (r job) FindByAccount(params *pagination.Params, address casper.Hash, role *entities.JobRole, filters map[string]interface{}) ([]*entities.Job, error) {
+	queryBuilder := query.Select("*").
+		From("jobs").
+		Where(r.accountRolePredicate(address, role)).
+		FilterBy(filters, r.indexedFields).
+		Paginate(params, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*entities.Job, 0)
+	if err := r.conn.Select(&jobs, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+func (r job) accountRolePredicate(address casper.Hash, role *entities.JobRole) sq.Sqlizer {
+	if role != nil {
+		switch *role {
+		case entities.JobRolePoster:
+			return sq.Eq{"job_poster": address}
+		case entities.JobRoleWorker:
+			return sq.Eq{"worker": address}
+		}
+	}
+
+	return sq.Or{sq.Eq{"job_poster": address}, sq.Eq{"worker": address}}
+}
+
+// CalculateWorkerTimeFrames compares proposed time frame of the picked bid with the time between job creation and submission,
+// only submitted jobs with the creation tracked from the deploy (not restored by migration) are taken into account
+func (r job) CalculateWorkerTimeFrames(worker casper.Hash) (entities.JobTimeFrames, error) {
+	query := `
+	SELECT 
+	    CAST(COALESCE(AVG(bids.proposed_time_frame), 0) AS UNSIGNED) as avg_proposed_time_frame,
+	    CAST(COALESCE(AVG(TIMESTAMPDIFF(SECOND, created.timestamp, submitted.timestamp)), 0) AS UNSIGNED) as avg_actual_time_frame
+	FROM jobs
+	    JOIN bids ON bids.bid_id = jobs.bid_id
+	    JOIN job_status_changes created ON created.job_id = jobs.job_id AND created.job_status_id = ? AND created.is_restored = 0
+	    JOIN job_status_changes submitted ON submitted.job_id = jobs.job_id AND submitted.job_status_id = ?
+	WHERE jobs.worker = ?;
+`
+
+	timeFrames := entities.JobTimeFrames{}
+	if err := r.conn.Get(&timeFrames, query, entities.JobStatusIDCreated, entities.JobStatusIDSubmitted, worker); err != nil {
+		return entities.JobTimeFrames{}, err
+	}
+
+	return timeFrames, nil
+}
@@ -1,10 +1,14 @@
 package repositories
 
 import (
+	"database/sql"
+
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/make-software/casper-go-sdk/casper"
 
 	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/errors"
 	"casper-dao-middleware/pkg/pagination"
 	"casper-dao-middleware/pkg/query"
 )
@@ -16,6 +20,9 @@ type JobOffer interface {
 	Save(jobOffer *entities.JobOffer) error
 	Count(filters map[string]interface{}) (uint64, error)
 	Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.JobOffer, error)
+	CountByAccount(address casper.Hash, filters map[string]interface{}) (uint64, error)
+	FindByAccount(params *pagination.Params, address casper.Hash, filters map[string]interface{}) ([]*entities.JobOffer, error)
+	GetByID(jobOfferID uint32) (*entities.JobOffer, error)
 	UpdateAuctionType(jobOfferID uint32, auctionType entities.AuctionTypeID) error
 }
 
@@ -26,8 +33,15 @@ type jobOffer struct {
 
 func NewJobOffer(conn *sqlx.DB) *jobOffer {
 	return &jobOffer{
-		conn:          conn,
-		indexedFields: map[string]struct{}{},
+		conn: conn,
+		indexedFields: map[string]struct{}{
+			"job_offer_id":        {},
+			"job_poster":          {},
+			"auction_type_id":     {},
+			"max_budget":          {},
+			"expected_time_frame": {},
+			"timestamp":           {},
+		},
 	}
 }
 
@@ -66,9 +80,9 @@ func (r *jobOffer) Save(jobOffer *entities.JobOffer) error {
 }
 
 func (r *jobOffer) Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.JobOffer, error) {
-	queryBuilder := query.Select("*").
+	queryBuilder := r.filterJobOffers(query.Select("*").
 		From("job_offers").
-		FilterBy(filters, r.indexedFields).
+		FilterBy(filters, r.indexedFields), filters).
 		Paginate(params, r.indexedFields)
 
 	sql, args, err := queryBuilder.ToSql()
@@ -85,9 +99,9 @@ func (r *jobOffer) Find(params *pagination.Params, filters map[string]interface{
 }
 
 func (r *jobOffer) Count(filters map[string]interface{}) (uint64, error) {
-	queryBuilder := query.Select("COUNT(*)").
+	queryBuilder := r.filterJobOffers(query.Select("COUNT(*)").
 		From("job_offers").
-		FilterBy(filters, r.indexedFields)
+		FilterBy(filters, r.indexedFields), filters)
 
 	sql, args, err := queryBuilder.ToSql()
 	if err != nil {
@@ -125,3 +139,113 @@ func (r *jobOffer) UpdateAuctionType(jobOfferID uint32, auctionType entities.Auc
 	}
 	return nil
 }
+
+func (r *jobOffer) GetByID(jobOfferID uint32) (*entities.JobOffer, error) {
+	queryBuilder := query.Select("*").
+		From("job_offers").
+		Where(sq.Eq{
+			"job_offer_id": jobOfferID,
+		})
+
+	sqlQuery, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	jobOffer := entities.JobOffer{}
+	if err := r.conn.Get(&jobOffer, sqlQuery, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("not found job offer by job_offer_id")
+		}
+		return nil, err
+	}
+
+	return &jobOffer, nil
+}
+
+// filterJobOffers applies range filters and the filters by state derived from bids and jobs
+func (r *jobOffer) filterJobOffers(queryBuilder *query.SelectBuilder, filters map[string]interface{}) *query.SelectBuilder {
+	if maxBudgetFrom, ok := filters["max_budget_from"]; ok {
+		queryBuilder = queryBuilder.Where(sq.GtOrEq{"max_budget": maxBudgetFrom})
+	}
+	if maxBudgetTo, ok := filters["max_budget_to"]; ok {
+		queryBuilder = queryBuilder.Where(sq.LtOrEq{"max_budget": maxBudgetTo})
+	}
+	if timeFrameFrom, ok := filters["expected_time_frame_from"]; ok {
+		queryBuilder = queryBuilder.Where(sq.GtOrEq{"expected_time_frame": timeFrameFrom})
+	}
+	if timeFrameTo, ok := filters["expected_time_frame_to"]; ok {
+		queryBuilder = queryBuilder.Where(sq.LtOrEq{"expected_time_frame": timeFrameTo})
+	}
+	if from, ok := filters["from"]; ok {
+		queryBuilder = queryBuilder.Where(sq.GtOrEq{"job_offers.timestamp": from})
+	}
+	if to, ok := filters["to"]; ok {
+		queryBuilder = queryBuilder.Where(sq.LtOrEq{"job_offers.timestamp": to})
+	}
+
+	if state, ok := filters["state"].(entities.JobOfferState); ok {
+		switch state {
+		case entities.JobOfferStateOpen:
+			queryBuilder = queryBuilder.Where(`NOT EXISTS (SELECT 1 FROM bids 
+				WHERE bids.job_offer_id = job_offers.job_offer_id AND bids.picked_by_job_poster = 1)`)
+		case entities.JobOfferStateHasJob:
+			queryBuilder = queryBuilder.Where(`EXISTS (SELECT 1 FROM bids JOIN jobs ON jobs.bid_id = bids.bid_id 
+				WHERE bids.job_offer_id = job_offers.job_offer_id)`)
+		}
+	}
+
+	if jobStatusIDs, ok := filters["job_status_id"].([]entities.JobStatusID); ok && len(jobStatusIDs) != 0 {
+		args := make([]interface{}, 0, len(jobStatusIDs))
+		for _, jobStatusID := range jobStatusIDs {
+			args = append(args, jobStatusID)
+		}
+
+		queryBuilder = queryBuilder.Where(`EXISTS (SELECT 1 FROM bids JOIN jobs ON jobs.bid_id = bids.bid_id 
+				WHERE bids.job_offer_id = job_offers.job_offer_id AND jobs.job_status_id IN (`+sq.Placeholders(len(args))+`))`, args...)
+	}
+
+	return queryBuilder
+}
+
+// CountByAccount counts JobOffers posted by the account
+func (r *jobOffer) CountByAccount(address casper.Hash, filters map[string]interface{}) (uint64, error) {
+	queryBuilder := r.filterJobOffers(query.Select("COUNT(*)").
+		From("job_offers").
+		Where(sq.Eq{"job_poster": address}).
+		FilterBy(filters, r.indexedFields), filters)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// FindByAccount finds JobOffers posted by the account
+func (r *jobOffer) FindByAccount(params *pagination.Params, address casper.Hash, filters map[string]interface{}) ([]*entities.JobOffer, error) {
+	queryBuilder := r.filterJobOffers(query.Select("*").
+		From("job_offers").
+		Where(sq.Eq{"job_poster": address}).
+		FilterBy(filters, r.indexedFields), filters).
+		Paginate(params, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	offers := make([]*entities.JobOffer, 0)
+	if err := r.conn.Select(&offers, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return offers, nil
+}
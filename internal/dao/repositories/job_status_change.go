@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/query"
+)
+
+// JobStatusChange DB table interface
+//
+//go:generate mockgen -destination=../tests/mocks/job_status_change_mock.go -package=mocks -source=./job_status_change.go JobStatusChange
+type JobStatusChange interface {
+	Save(change *entities.JobStatusChange) error
+	Count(filters map[string]interface{}) (uint64, error)
+	Find(params *pagination.Params, filters map[string]interface{}) ([]entities.JobStatusChange, error)
+}
+
+type jobStatusChange struct {
+	conn          *sqlx.DB
+	indexedFields map[string]struct{}
+}
+
+func NewJobStatusChange(conn *sqlx.DB) JobStatusChange {
+	return &jobStatusChange{
+		conn: conn,
+		indexedFields: map[string]struct{}{
+			"job_id":        {},
+			"bid_id":        {},
+			"job_status_id": {},
+			"timestamp":     {},
+		},
+	}
+}
+
+func (r *jobStatusChange) Save(change *entities.JobStatusChange) error {
+	queryBuilder := query.Insert("job_status_changes").
+		Options("IGNORE").
+		Columns(
+			"job_id",
+			"bid_id",
+			"job_status_id",
+			"caller",
+			"result",
+			"deploy_hash",
+			"timestamp",
+		).
+		Values(
+			change.JobID,
+			change.BidID,
+			change.JobStatusID,
+			change.Caller,
+			change.Result,
+			change.DeployHash,
+			change.Timestamp,
+		)
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *jobStatusChange) Count(filters map[string]interface{}) (uint64, error) {
+	queryBuilder := query.Select("COUNT(*)").
+		From("job_status_changes").
+		FilterBy(filters, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *jobStatusChange) Find(params *pagination.Params, filters map[string]interface{}) ([]entities.JobStatusChange, error) {
+	queryBuilder := query.Select("*").
+		From("job_status_changes").
+		FilterBy(filters, r.indexedFields).
+		Paginate(params, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]entities.JobStatusChange, 0)
+	if err := r.conn.Select(&changes, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
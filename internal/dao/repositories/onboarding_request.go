@@ -0,0 +1,198 @@
+package repositories
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/errors"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/query"
+)
+
+// OnboardingRequest DB table interface
+//
+//go:generate mockgen -destination=../tests/mocks/onboarding_request_mock.go -package=mocks -source=./onboarding_request.go OnboardingRequest
+type OnboardingRequest interface {
+	Save(request *entities.OnboardingRequest) error
+	GetByVotingID(votingID uint32) (*entities.OnboardingRequest, error)
+	GetAwaitingVAGrant(requester casper.Hash) (*entities.OnboardingRequest, error)
+	Update(request *entities.OnboardingRequest) error
+	Count(filters map[string]interface{}) (uint64, error)
+	Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.OnboardingRequest, error)
+}
+
+type onboardingRequest struct {
+	conn          *sqlx.DB
+	indexedFields map[string]struct{}
+}
+
+func NewOnboardingRequest(conn *sqlx.DB) OnboardingRequest {
+	return &onboardingRequest{
+		conn: conn,
+		indexedFields: map[string]struct{}{
+			"voting_id":                    {},
+			"requester":                    {},
+			"cspr_deposit":                 {},
+			"onboarding_request_status_id": {},
+			"deposit_status_id":            {},
+			"timestamp":                    {},
+		},
+	}
+}
+
+func (r *onboardingRequest) Save(request *entities.OnboardingRequest) error {
+	queryBuilder := query.Insert("onboarding_requests").
+		Options("IGNORE").
+		Columns(
+			"voting_id",
+			"requester",
+			"reason",
+			"cspr_deposit",
+			"onboarding_request_status_id",
+			"deposit_status_id",
+			"va_token_id",
+			"va_granted_deploy_hash",
+			"deploy_hash",
+			"timestamp",
+			"resolved_at",
+		).
+		Values(
+			request.VotingID,
+			request.Requester,
+			request.Reason,
+			request.CSPRDeposit,
+			request.OnboardingRequestStatusID,
+			request.DepositStatusID,
+			request.VATokenID,
+			request.VAGrantedDeployHash,
+			request.DeployHash,
+			request.Timestamp,
+			request.ResolvedAt,
+		)
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *onboardingRequest) GetByVotingID(votingID uint32) (*entities.OnboardingRequest, error) {
+	queryBuilder := query.Select("*").
+		From("onboarding_requests").
+		Where(sq.Eq{
+			"voting_id": votingID,
+		})
+
+	return r.get(queryBuilder, "not found onboarding request by voting_id")
+}
+
+// GetAwaitingVAGrant returns the latest pending or accepted OnboardingRequest of the requester which is not linked to VA NFT yet,
+// pending requests are included as the VA NFT could be minted before the onboarding voting end is tracked within the same deploy
+func (r *onboardingRequest) GetAwaitingVAGrant(requester casper.Hash) (*entities.OnboardingRequest, error) {
+	queryBuilder := query.Select("*").
+		From("onboarding_requests").
+		Where(sq.Eq{
+			"requester": requester,
+			"onboarding_request_status_id": []entities.OnboardingRequestStatusID{
+				entities.OnboardingRequestStatusIDPending,
+				entities.OnboardingRequestStatusIDAccepted,
+			},
+			"va_token_id": nil,
+		}).
+		OrderBy("voting_id DESC").
+		Limit(1)
+
+	return r.get(queryBuilder, "not found onboarding request awaiting VA grant")
+}
+
+func (r *onboardingRequest) Update(request *entities.OnboardingRequest) error {
+	queryBuilder := query.Update("onboarding_requests").
+		SetMap(map[string]interface{}{
+			"onboarding_request_status_id": request.OnboardingRequestStatusID,
+			"deposit_status_id":            request.DepositStatusID,
+			"va_token_id":                  request.VATokenID,
+			"va_granted_deploy_hash":       request.VAGrantedDeployHash,
+			"resolved_at":                  request.ResolvedAt,
+		})
+
+	queryBuilder = queryBuilder.
+		Where(sq.Eq{
+			"voting_id": request.VotingID,
+		})
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *onboardingRequest) Count(filters map[string]interface{}) (uint64, error) {
+	queryBuilder := query.Select("COUNT(*)").
+		From("onboarding_requests").
+		FilterBy(filters, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *onboardingRequest) Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.OnboardingRequest, error) {
+	queryBuilder := query.Select("*").
+		From("onboarding_requests").
+		FilterBy(filters, r.indexedFields).
+		Paginate(params, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*entities.OnboardingRequest, 0)
+	if err := r.conn.Select(&requests, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+func (r *onboardingRequest) get(queryBuilder *query.SelectBuilder, notFoundMessage string) (*entities.OnboardingRequest, error) {
+	sqlQuery, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	request := entities.OnboardingRequest{}
+	if err := r.conn.Get(&request, sqlQuery, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError(notFoundMessage)
+		}
+		return nil, err
+	}
+
+	return &request, nil
+}
@@ -0,0 +1,176 @@
+package repositories
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/utils"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/query"
+)
+
+// ReputationBalance DB table interface
+//
+//go:generate mockgen -destination=../tests/mocks/reputation_balance_repo_mock.go -package=mocks -source=./reputation_balance.go ReputationBalance
+type ReputationBalance interface {
+	CountAggregated(filters map[string]interface{}) (uint64, error)
+	FindAggregated(params *pagination.Params, filters map[string]interface{}) ([]entities.AggregatedReputationBalance, error)
+	FindMismatches() ([]entities.ReputationBalanceMismatch, error)
+	Rebuild() error
+}
+
+type reputationBalance struct {
+	conn          *sqlx.DB
+	indexedFields map[string]struct{}
+
+	contractPackageHashes utils.DAOContractsMetadata
+}
+
+func NewReputationBalance(conn *sqlx.DB, hashes utils.DAOContractsMetadata) ReputationBalance {
+	return &reputationBalance{
+		conn: conn,
+		indexedFields: map[string]struct{}{
+			"address":           {},
+			"liquid_reputation": {},
+			"staked_reputation": {},
+			"total_reputation":  {},
+		},
+		contractPackageHashes: hashes,
+	}
+}
+
+func (r *reputationBalance) CountAggregated(filters map[string]interface{}) (uint64, error) {
+	queryBuilder := r.filterAggregated(query.Select("COUNT(DISTINCT address)"), filters)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *reputationBalance) FindAggregated(params *pagination.Params, filters map[string]interface{}) ([]entities.AggregatedReputationBalance, error) {
+	queryBuilder := query.Select("address").
+		Column(sq.Expr("CAST(SUM(IF(contract_package_hash = ?, amount, 0)) AS SIGNED) AS liquid_reputation", r.contractPackageHashes.ReputationContractPackageHash)).
+		Column(sq.Expr("CAST(SUM(IF(contract_package_hash != ?, amount, 0)) AS SIGNED) AS staked_reputation", r.contractPackageHashes.ReputationContractPackageHash)).
+		Column("CAST(SUM(amount) AS SIGNED) AS total_reputation")
+
+	queryBuilder = r.filterAggregated(queryBuilder, filters).
+		GroupBy("address").
+		Paginate(params, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]entities.AggregatedReputationBalance, 0)
+	if err := r.conn.Select(&balances, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// filterAggregated chooses the source of the aggregation: the running balances by default,
+// or the reputation_changes ledger when the result is limited by time window
+func (r *reputationBalance) filterAggregated(queryBuilder *query.SelectBuilder, filters map[string]interface{}) *query.SelectBuilder {
+	from, hasFrom := filters["from"].(time.Time)
+	to, hasTo := filters["to"].(time.Time)
+
+	if hasFrom || hasTo {
+		queryBuilder = queryBuilder.From("reputation_changes")
+		if hasFrom {
+			queryBuilder = queryBuilder.Where(sq.GtOrEq{"reputation_changes.timestamp": from})
+		}
+		if hasTo {
+			queryBuilder = queryBuilder.Where(sq.LtOrEq{"reputation_changes.timestamp": to})
+		}
+	} else {
+		queryBuilder = queryBuilder.From("reputation_balances")
+	}
+
+	if isVA, ok := filters["is_va"].(bool); ok {
+		if isVA {
+			queryBuilder = queryBuilder.
+				Join("accounts ON accounts.hash = address").
+				Where(sq.Eq{"accounts.is_va": true})
+		} else {
+			// addresses without accounts record have never received VA NFT
+			queryBuilder = queryBuilder.
+				LeftJoin("accounts ON accounts.hash = address").
+				Where(sq.Or{sq.Eq{"accounts.is_va": nil}, sq.Eq{"accounts.is_va": false}})
+		}
+	}
+
+	return queryBuilder
+}
+
+// FindMismatches compares the running balances with the sums over the full reputation_changes ledger
+func (r *reputationBalance) FindMismatches() ([]entities.ReputationBalanceMismatch, error) {
+	query := `
+	SELECT 
+	    ledger.address, 
+	    ledger.contract_package_hash, 
+	    CAST(COALESCE(reputation_balances.amount, 0) AS SIGNED) as balance_amount, 
+	    ledger.amount as ledger_amount
+	FROM (
+	    SELECT address, contract_package_hash, CAST(SUM(amount) AS SIGNED) as amount 
+	    FROM reputation_changes GROUP BY address, contract_package_hash
+	) ledger
+	LEFT JOIN reputation_balances 
+	    ON reputation_balances.address = ledger.address AND reputation_balances.contract_package_hash = ledger.contract_package_hash
+	WHERE COALESCE(reputation_balances.amount, 0) != ledger.amount
+	UNION ALL
+	SELECT 
+	    reputation_balances.address, 
+	    reputation_balances.contract_package_hash, 
+	    reputation_balances.amount as balance_amount, 
+	    0 as ledger_amount
+	FROM reputation_balances
+	LEFT JOIN (
+	    SELECT DISTINCT address, contract_package_hash FROM reputation_changes
+	) ledger 
+	    ON reputation_balances.address = ledger.address AND reputation_balances.contract_package_hash = ledger.contract_package_hash
+	WHERE ledger.address IS NULL AND reputation_balances.amount != 0;
+`
+
+	mismatches := make([]entities.ReputationBalanceMismatch, 0)
+	if err := r.conn.Select(&mismatches, query); err != nil {
+		return nil, err
+	}
+
+	return mismatches, nil
+}
+
+// Rebuild recalculates all the running balances from the reputation_changes ledger
+func (r *reputationBalance) Rebuild() error {
+	tx, err := r.conn.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM reputation_balances`); err != nil {
+		return err
+	}
+
+	insertQuery := `INSERT INTO reputation_balances (address, contract_package_hash, amount)
+		SELECT address, contract_package_hash, SUM(amount) FROM reputation_changes GROUP BY address, contract_package_hash`
+
+	if _, err := tx.Exec(insertQuery); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -1,8 +1,12 @@
 package repositories
 
 import (
+	"database/sql"
 	"fmt"
 	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
 
 	"github.com/jmoiron/sqlx"
 
@@ -10,6 +14,9 @@ import (
 
 	"casper-dao-middleware/internal/dao/entities"
 	"casper-dao-middleware/internal/dao/utils"
+	"casper-dao-middleware/pkg/errors"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/query"
 )
 
 // ReputationChange DB table interface
@@ -17,8 +24,13 @@ import (
 //go:generate mockgen -destination=../tests/mocks/reputation_change_repo_mock.go -package=mocks -source=./reputation_change.go ReputationChange
 type ReputationChange interface {
 	SaveBatch(changes []entities.ReputationChange) error
+	Count(filters map[string]interface{}) (uint64, error)
+	Find(params *pagination.Params, filters map[string]interface{}) ([]entities.ReputationChange, error)
 	CalculateLiquidStakeReputationForAddress(address casper.Hash) (entities.LiquidStakeReputation, error)
 	CalculateAggregatedLiquidStakeReputationForAddresses(addresses []casper.Hash) ([]entities.LiquidStakeReputation, error)
+	CalculateBalancesForAddressAt(address casper.Hash, asOf time.Time) ([]entities.ReputationBalance, error)
+	CalculateBalancesForAddressAtDeploy(address casper.Hash, deployHash casper.Hash, deployTimestamp time.Time) ([]entities.ReputationBalance, error)
+	GetTimestampByDeployHash(deployHash casper.Hash) (time.Time, error)
 }
 
 type reputationChange struct {
@@ -32,7 +44,12 @@ func NewReputationChange(conn *sqlx.DB, hashes utils.DAOContractsMetadata) *repu
 	return &reputationChange{
 		conn: conn,
 		indexedFields: map[string]struct{}{
-			"address": {},
+			"address":               {},
+			"contract_package_hash": {},
+			"voting_id":             {},
+			"reason":                {},
+			"amount":                {},
+			"timestamp":             {},
 		},
 		contractPackageHashes: hashes,
 	}
@@ -49,23 +66,97 @@ func (r *reputationChange) SaveBatch(changes []entities.ReputationChange) error
 		"timestamp",
 	}
 
-	insertQuery := `INSERT IGNORE INTO reputation_changes (` + strings.Join(columns, ",") + `) 
+	insertQuery := `INSERT IGNORE INTO reputation_changes (` + strings.Join(columns, ",") + `)
 		VALUES (:` + strings.Join(columns, ",:") + `)`
 
-	_, err := r.conn.NamedExec(insertQuery, changes)
+	// reputation_balances is maintained in the same transaction, so it is incremented only by the changes
+	// which were really inserted (already tracked changes are ignored on reprocessing the same deploy)
+	balanceQuery := `INSERT INTO reputation_balances (address, contract_package_hash, amount)
+		VALUES (:address, :contract_package_hash, :amount) ON DUPLICATE KEY UPDATE amount = amount + values(amount)`
+
+	tx, err := r.conn.Beginx()
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
+
+	for _, change := range changes {
+		result, err := tx.NamedExec(insertQuery, change)
+		if err != nil {
+			return err
+		}
+
+		inserted, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if inserted == 0 {
+			continue
+		}
+
+		if _, err := tx.NamedExec(balanceQuery, change); err != nil {
+			return err
+		}
+	}
 
-	return nil
+	return tx.Commit()
+}
+
+func (r *reputationChange) Count(filters map[string]interface{}) (uint64, error) {
+	queryBuilder := r.filterByTimeRange(query.Select("COUNT(*)").
+		From("reputation_changes").
+		FilterBy(filters, r.indexedFields), filters)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *reputationChange) Find(params *pagination.Params, filters map[string]interface{}) ([]entities.ReputationChange, error) {
+	queryBuilder := r.filterByTimeRange(query.Select("*").
+		From("reputation_changes").
+		FilterBy(filters, r.indexedFields), filters).
+		Paginate(params, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]entities.ReputationChange, 0)
+	if err := r.conn.Select(&changes, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func (r *reputationChange) filterByTimeRange(queryBuilder *query.SelectBuilder, filters map[string]interface{}) *query.SelectBuilder {
+	if from, ok := filters["from"].(time.Time); ok {
+		queryBuilder = queryBuilder.Where(sq.GtOrEq{"timestamp": from})
+	}
+	if to, ok := filters["to"].(time.Time); ok {
+		queryBuilder = queryBuilder.Where(sq.LtOrEq{"timestamp": to})
+	}
+	return queryBuilder
 }
 
 func (r *reputationChange) CalculateLiquidStakeReputationForAddress(address casper.Hash) (entities.LiquidStakeReputation, error) {
 	query := `
 	SELECT 
-	    (SELECT ABS(SUM(amount)) FROM reputation_changes WHERE contract_package_hash = ? and address = ?) as liquid_amount, 
-	    (SELECT ABS(SUM(amount))  FROM reputation_changes WHERE contract_package_hash != ? and address = ?) as staked_amount  
-	FROM reputation_changes WHERE address = ?;
+	    (SELECT ABS(SUM(amount)) FROM reputation_balances WHERE contract_package_hash = ? and address = ?) as liquid_amount, 
+	    (SELECT ABS(SUM(amount))  FROM reputation_balances WHERE contract_package_hash != ? and address = ?) as staked_amount  
+	FROM reputation_balances WHERE address = ? LIMIT 1;
 `
 
 	args := []interface{}{
@@ -96,7 +187,7 @@ func (r *reputationChange) CalculateAggregatedLiquidStakeReputationForAddresses(
 	SELECT 
 	    ABS(SUM(amount)) as liquid_amount,
 		address
-	FROM reputation_changes  WHERE contract_package_hash = ? and address in (%s) GROUP BY address;
+	FROM reputation_balances  WHERE contract_package_hash = ? and address in (%s) GROUP BY address;
 `, strings.Join(addressesParams, ","))
 
 	args := []interface{}{
@@ -120,7 +211,7 @@ func (r *reputationChange) CalculateAggregatedLiquidStakeReputationForAddresses(
 	SELECT 
 	    ABS(SUM(amount)) as staked_amount,
 	    address
-	FROM reputation_changes  WHERE contract_package_hash != ? and address in (%s) GROUP BY address;
+	FROM reputation_balances  WHERE contract_package_hash != ? and address in (%s) GROUP BY address;
 `, strings.Join(addressesParams, ","))
 
 	stakeReputations := make([]entities.LiquidStakeReputation, 0)
@@ -135,3 +226,71 @@ func (r *reputationChange) CalculateAggregatedLiquidStakeReputationForAddresses(
 
 	return stakeReputations, nil
 }
+
+func (r *reputationChange) CalculateBalancesForAddressAt(address casper.Hash, asOf time.Time) ([]entities.ReputationBalance, error) {
+	queryBuilder := query.Select("address", "contract_package_hash", "CAST(SUM(amount) AS SIGNED) AS amount").
+		From("reputation_changes").
+		Where(sq.Eq{"address": address}).
+		Where(sq.LtOrEq{"timestamp": asOf}).
+		GroupBy("address", "contract_package_hash")
+
+	sqlQuery, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]entities.ReputationBalance, 0)
+	if err := r.conn.Select(&balances, sqlQuery, args...); err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// CalculateBalancesForAddressAtDeploy rebuilds balances right after the given deploy: changes of the deploy itself
+// plus all changes from the earlier blocks, other deploys from the same second are not included
+func (r *reputationChange) CalculateBalancesForAddressAtDeploy(address casper.Hash, deployHash casper.Hash, deployTimestamp time.Time) ([]entities.ReputationBalance, error) {
+	queryBuilder := query.Select("address", "contract_package_hash", "CAST(SUM(amount) AS SIGNED) AS amount").
+		From("reputation_changes").
+		Where(sq.Eq{"address": address}).
+		Where(sq.Or{
+			sq.Lt{"timestamp": deployTimestamp},
+			sq.Eq{"deploy_hash": deployHash},
+		}).
+		GroupBy("address", "contract_package_hash")
+
+	sqlQuery, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]entities.ReputationBalance, 0)
+	if err := r.conn.Select(&balances, sqlQuery, args...); err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// GetTimestampByDeployHash resolves the block timestamp of the deploy which changed the reputation
+func (r *reputationChange) GetTimestampByDeployHash(deployHash casper.Hash) (time.Time, error) {
+	queryBuilder := query.Select("timestamp").
+		From("reputation_changes").
+		Where(sq.Eq{"deploy_hash": deployHash}).
+		Limit(1)
+
+	sqlQuery, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var timestamp time.Time
+	if err := r.conn.Get(&timestamp, sqlQuery, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return time.Time{}, errors.NewNotFoundError("not found reputation changes by deploy_hash")
+		}
+		return time.Time{}, err
+	}
+
+	return timestamp, nil
+}
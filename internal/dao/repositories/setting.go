@@ -66,16 +66,18 @@ func (r *setting) Upsert(setting entities.Setting) error {
 		Columns(
 			"name",
 			"value",
+			"value_type_id",
 			"next_value",
 			"activation_time",
 		).
 		Values(
 			setting.Name,
 			setting.Value,
+			setting.ValueTypeID,
 			setting.NextValue,
 			setting.ActivationTime,
 		).
-		Suffix("ON DUPLICATE KEY UPDATE value = values(value), next_value = values(next_value), activation_time = values(activation_time)")
+		Suffix("ON DUPLICATE KEY UPDATE value = values(value), value_type_id = values(value_type_id), next_value = values(next_value), activation_time = values(activation_time)")
 
 	sql, args, err := queryBuilder.ToSql()
 	if err != nil {
@@ -90,21 +92,25 @@ func (r *setting) Upsert(setting entities.Setting) error {
 	return nil
 }
 
+// Save stores the setting if it is not tracked yet, the value type of the existing setting is restored if it is unknown
 func (r *setting) Save(setting entities.Setting) error {
 	queryBuilder := query.Insert("settings").
-		Options("IGNORE").
 		Columns(
 			"name",
 			"value",
+			"value_type_id",
 			"next_value",
 			"activation_time",
 		).
 		Values(
 			setting.Name,
 			setting.Value,
+			setting.ValueTypeID,
 			setting.NextValue,
 			setting.ActivationTime,
-		)
+		).
+		Suffix("ON DUPLICATE KEY UPDATE value_type_id = coalesce(value_type_id, values(value_type_id))")
+
 	sql, args, err := queryBuilder.ToSql()
 	if err != nil {
 		return err
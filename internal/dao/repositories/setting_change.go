@@ -0,0 +1,188 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/errors"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/query"
+)
+
+// SettingChange DB table interface
+//
+//go:generate mockgen -destination=../tests/mocks/setting_change_mock.go -package=mocks -source=./setting_change.go SettingChange
+type SettingChange interface {
+	Save(change *entities.SettingChange) error
+	GetEffectiveAt(name string, at time.Time) (*entities.SettingChange, error)
+	FindEffectiveAt(at time.Time) ([]entities.SettingChange, error)
+	Count(filters map[string]interface{}) (uint64, error)
+	Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.SettingChange, error)
+}
+
+type settingChange struct {
+	conn          *sqlx.DB
+	indexedFields map[string]struct{}
+}
+
+func NewSettingChange(conn *sqlx.DB) SettingChange {
+	return &settingChange{
+		conn: conn,
+		indexedFields: map[string]struct{}{
+			"name":         {},
+			"effective_at": {},
+			"timestamp":    {},
+		},
+	}
+}
+
+func (r *settingChange) Save(change *entities.SettingChange) error {
+	queryBuilder := query.Insert("setting_changes").
+		Options("IGNORE").
+		Columns(
+			"name",
+			"value",
+			"value_type_id",
+			"activation_time",
+			"effective_at",
+			"setting_change_source_id",
+			"deploy_hash",
+			"timestamp",
+		).
+		Values(
+			change.Name,
+			change.Value,
+			change.ValueTypeID,
+			change.ActivationTime,
+			change.EffectiveAt,
+			change.SettingChangeSourceID,
+			change.DeployHash,
+			change.Timestamp,
+		)
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetEffectiveAt returns the latest change of the setting which was announced and became current before the provided time,
+// scheduled changes replaced before their activation are skipped
+func (r *settingChange) GetEffectiveAt(name string, at time.Time) (*entities.SettingChange, error) {
+	queryBuilder := query.Select("sc.*").
+		From("setting_changes sc").
+		Where(sq.Eq{
+			"sc.name": name,
+		}).
+		Where(sq.LtOrEq{
+			"sc.effective_at": at,
+			"sc.timestamp":    at,
+		}).
+		Where(notSupersededSettingChange("sc")).
+		OrderBy("sc.effective_at DESC", "sc.timestamp DESC").
+		Limit(1)
+
+	sqlQuery, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	change := entities.SettingChange{}
+	if err := r.conn.Get(&change, sqlQuery, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("not found setting value effective at the provided time")
+		}
+		return nil, err
+	}
+
+	return &change, nil
+}
+
+// FindEffectiveAt returns the changes of all the settings which were current at the provided time,
+// scheduled changes replaced before their activation are skipped
+func (r *settingChange) FindEffectiveAt(at time.Time) ([]entities.SettingChange, error) {
+	queryBuilder := query.Select("sc.*").
+		From("setting_changes sc").
+		Where(sq.LtOrEq{
+			"sc.effective_at": at,
+			"sc.timestamp":    at,
+		}).
+		Where(notSupersededSettingChange("sc")).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM setting_changes later
+			WHERE later.name = sc.name AND later.effective_at <= ? AND later.timestamp <= ?
+			AND (later.effective_at > sc.effective_at OR (later.effective_at = sc.effective_at AND later.timestamp > sc.timestamp))
+			AND `+notSupersededSettingChange("later")+`
+		)`, at, at)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]entities.SettingChange, 0)
+	if err := r.conn.Select(&changes, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// notSupersededSettingChange filters out the scheduled change of the aliased table when another change of the setting
+// was announced after it and before its activation time, as the update replaces the pending value
+func notSupersededSettingChange(alias string) string {
+	return fmt.Sprintf(`NOT EXISTS (
+			SELECT 1 FROM setting_changes superseding
+			WHERE superseding.name = %[1]s.name
+			AND superseding.timestamp > %[1]s.timestamp AND superseding.timestamp < %[1]s.activation_time
+		)`, alias)
+}
+
+func (r *settingChange) Count(filters map[string]interface{}) (uint64, error) {
+	queryBuilder := query.Select("COUNT(*)").
+		From("setting_changes").
+		FilterBy(filters, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *settingChange) Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.SettingChange, error) {
+	queryBuilder := query.Select("*").
+		From("setting_changes").
+		FilterBy(filters, r.indexedFields).
+		Paginate(params, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*entities.SettingChange, 0)
+	if err := r.conn.Select(&changes, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,167 @@
+package repositories
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/errors"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/query"
+)
+
+// SettingProposal DB table interface
+//
+//go:generate mockgen -destination=../tests/mocks/setting_proposal_mock.go -package=mocks -source=./setting_proposal.go SettingProposal
+type SettingProposal interface {
+	Save(proposal *entities.SettingProposal) error
+	GetByVotingID(votingID uint32) (*entities.SettingProposal, error)
+	Update(proposal *entities.SettingProposal) error
+	Count(filters map[string]interface{}) (uint64, error)
+	Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.SettingProposal, error)
+}
+
+type settingProposal struct {
+	conn          *sqlx.DB
+	indexedFields map[string]struct{}
+}
+
+func NewSettingProposal(conn *sqlx.DB) SettingProposal {
+	return &settingProposal{
+		conn: conn,
+		indexedFields: map[string]struct{}{
+			"voting_id":                  {},
+			"name":                       {},
+			"setting_proposal_status_id": {},
+			"activation_time":            {},
+			"timestamp":                  {},
+		},
+	}
+}
+
+func (r *settingProposal) Save(proposal *entities.SettingProposal) error {
+	queryBuilder := query.Insert("setting_proposals").
+		Options("IGNORE").
+		Columns(
+			"voting_id",
+			"name",
+			"variable_repo_to_edit",
+			"proposed_value",
+			"value_type_id",
+			"activation_time",
+			"setting_proposal_status_id",
+			"deploy_hash",
+			"timestamp",
+			"resolved_at",
+		).
+		Values(
+			proposal.VotingID,
+			proposal.Name,
+			proposal.VariableRepoToEdit,
+			proposal.ProposedValue,
+			proposal.ValueTypeID,
+			proposal.ActivationTime,
+			proposal.SettingProposalStatusID,
+			proposal.DeployHash,
+			proposal.Timestamp,
+			proposal.ResolvedAt,
+		)
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *settingProposal) GetByVotingID(votingID uint32) (*entities.SettingProposal, error) {
+	queryBuilder := query.Select("*").
+		From("setting_proposals").
+		Where(sq.Eq{
+			"voting_id": votingID,
+		})
+
+	sqlQuery, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := entities.SettingProposal{}
+	if err := r.conn.Get(&proposal, sqlQuery, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("not found setting proposal by voting_id")
+		}
+		return nil, err
+	}
+
+	return &proposal, nil
+}
+
+func (r *settingProposal) Update(proposal *entities.SettingProposal) error {
+	queryBuilder := query.Update("setting_proposals").
+		SetMap(map[string]interface{}{
+			"setting_proposal_status_id": proposal.SettingProposalStatusID,
+			"resolved_at":                proposal.ResolvedAt,
+		})
+
+	queryBuilder = queryBuilder.
+		Where(sq.Eq{
+			"voting_id": proposal.VotingID,
+		})
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *settingProposal) Count(filters map[string]interface{}) (uint64, error) {
+	queryBuilder := query.Select("COUNT(*)").
+		From("setting_proposals").
+		FilterBy(filters, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *settingProposal) Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.SettingProposal, error) {
+	queryBuilder := query.Select("*").
+		From("setting_proposals").
+		FilterBy(filters, r.indexedFields).
+		Paginate(params, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	settingProposals := make([]*entities.SettingProposal, 0)
+	if err := r.conn.Select(&settingProposals, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return settingProposals, nil
+}
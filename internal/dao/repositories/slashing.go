@@ -0,0 +1,173 @@
+package repositories
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/errors"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/query"
+)
+
+// Slashing DB table interface
+//
+//go:generate mockgen -destination=../tests/mocks/slashing_mock.go -package=mocks -source=./slashing.go Slashing
+type Slashing interface {
+	Save(slashing *entities.Slashing) error
+	GetByVotingID(votingID uint32) (*entities.Slashing, error)
+	Update(slashing *entities.Slashing) error
+	Count(filters map[string]interface{}) (uint64, error)
+	Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.Slashing, error)
+}
+
+type slashing struct {
+	conn          *sqlx.DB
+	indexedFields map[string]struct{}
+}
+
+func NewSlashing(conn *sqlx.DB) Slashing {
+	return &slashing{
+		conn: conn,
+		indexedFields: map[string]struct{}{
+			"voting_id":          {},
+			"address_to_slash":   {},
+			"slash_ratio":        {},
+			"slashing_status_id": {},
+			"reputation_burned":  {},
+			"timestamp":          {},
+		},
+	}
+}
+
+func (r *slashing) Save(slashing *entities.Slashing) error {
+	queryBuilder := query.Insert("slashings").
+		Options("IGNORE").
+		Columns(
+			"voting_id",
+			"address_to_slash",
+			"slash_ratio",
+			"creator",
+			"slashing_status_id",
+			"reputation_burned",
+			"stakes_returned",
+			"deploy_hash",
+			"resolved_deploy_hash",
+			"timestamp",
+			"resolved_at",
+		).
+		Values(
+			slashing.VotingID,
+			slashing.AddressToSlash,
+			slashing.SlashRatio,
+			slashing.Creator,
+			slashing.SlashingStatusID,
+			slashing.ReputationBurned,
+			slashing.StakesReturned,
+			slashing.DeployHash,
+			slashing.ResolvedDeployHash,
+			slashing.Timestamp,
+			slashing.ResolvedAt,
+		)
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *slashing) GetByVotingID(votingID uint32) (*entities.Slashing, error) {
+	queryBuilder := query.Select("*").
+		From("slashings").
+		Where(sq.Eq{
+			"voting_id": votingID,
+		})
+
+	sqlQuery, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	slashing := entities.Slashing{}
+	if err := r.conn.Get(&slashing, sqlQuery, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("not found slashing by voting_id")
+		}
+		return nil, err
+	}
+
+	return &slashing, nil
+}
+
+func (r *slashing) Update(slashing *entities.Slashing) error {
+	queryBuilder := query.Update("slashings").
+		SetMap(map[string]interface{}{
+			"slashing_status_id":   slashing.SlashingStatusID,
+			"reputation_burned":    slashing.ReputationBurned,
+			"stakes_returned":      slashing.StakesReturned,
+			"resolved_deploy_hash": slashing.ResolvedDeployHash,
+			"resolved_at":          slashing.ResolvedAt,
+		})
+
+	queryBuilder = queryBuilder.
+		Where(sq.Eq{
+			"voting_id": slashing.VotingID,
+		})
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *slashing) Count(filters map[string]interface{}) (uint64, error) {
+	queryBuilder := query.Select("COUNT(*)").
+		From("slashings").
+		FilterBy(filters, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *slashing) Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.Slashing, error) {
+	queryBuilder := query.Select("*").
+		From("slashings").
+		FilterBy(filters, r.indexedFields).
+		Paginate(params, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	slashings := make([]*entities.Slashing, 0)
+	if err := r.conn.Select(&slashings, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return slashings, nil
+}
@@ -0,0 +1,319 @@
+package repositories
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/pagination"
+	"casper-dao-middleware/pkg/query"
+)
+
+// Stats DB interface of the DAO-wide aggregations over the existing tables
+//
+//go:generate mockgen -destination=../tests/mocks/stats_mock.go -package=mocks -source=./stats.go Stats
+type Stats interface {
+	CalculateTimeSeries(metric entities.TimeSeriesMetric, interval entities.TimeSeriesInterval, from, to *time.Time) ([]entities.TimeSeriesPoint, error)
+	CountVotingParticipation(filters map[string]interface{}) (uint64, error)
+	FindVotingParticipation(params *pagination.Params, filters map[string]interface{}) ([]entities.VotingParticipation, error)
+	CalculateVotingTypeParticipation() ([]entities.VotingTypeParticipation, error)
+}
+
+// timeSeriesSource describes how the metric is aggregated from the table
+type timeSeriesSource struct {
+	table           string
+	timestampColumn string
+	valueColumn     string
+	groupColumn     string
+	where           sq.Sqlizer
+}
+
+type stats struct {
+	conn              *sqlx.DB
+	indexedFields     map[string]struct{}
+	timeSeriesSources map[entities.TimeSeriesMetric]timeSeriesSource
+}
+
+func NewStats(conn *sqlx.DB) Stats {
+	return &stats{
+		conn: conn,
+		indexedFields: map[string]struct{}{
+			"voting_id":      {},
+			"voting_type_id": {},
+		},
+		timeSeriesSources: map[entities.TimeSeriesMetric]timeSeriesSource{
+			entities.TimeSeriesMetricVotingsCreated: {
+				table:           "votings",
+				timestampColumn: "informal_voting_starts_at",
+				valueColumn:     "COUNT(*)",
+				groupColumn:     "voting_type_id",
+			},
+			entities.TimeSeriesMetricBallotsCast: {
+				table:           "votes",
+				timestampColumn: "timestamp",
+				valueColumn:     "COUNT(*)",
+			},
+			entities.TimeSeriesMetricUniqueVoters: {
+				table:           "votes",
+				timestampColumn: "timestamp",
+				valueColumn:     "COUNT(DISTINCT address)",
+			},
+			// reputation supply grows with the Mint events and the voting rewards, both are recorded as the single positive leg
+			// of the Reputation contract, stake and unstake legs only move the reputation between contracts
+			entities.TimeSeriesMetricReputationMinted: {
+				table:           "reputation_changes",
+				timestampColumn: "timestamp",
+				valueColumn:     "SUM(amount)",
+				where: sq.And{
+					sq.Eq{"reason": []entities.ReputationChangeReason{entities.ReputationChangeReasonMinted, entities.ReputationChangeReasonVotingGained}},
+					sq.Gt{"amount": 0},
+				},
+			},
+			// reputation supply shrinks with the Burn events of the Reputation contract and the burned stakes of the lost votes,
+			// the latter are recorded as the single negative leg of the voter contract
+			entities.TimeSeriesMetricReputationBurned: {
+				table:           "reputation_changes",
+				timestampColumn: "timestamp",
+				valueColumn:     "SUM(-amount)",
+				where: sq.And{
+					sq.Eq{"reason": []entities.ReputationChangeReason{entities.ReputationChangeReasonBurned, entities.ReputationChangeReasonVotingLost}},
+					sq.Lt{"amount": 0},
+				},
+			},
+			entities.TimeSeriesMetricNewVAs: {
+				table:           "account_status_changes",
+				timestampColumn: "timestamp",
+				valueColumn:     "COUNT(DISTINCT address)",
+				where:           sq.Eq{"account_status_change_id": entities.AccountStatusChangeIDVAGranted},
+			},
+			entities.TimeSeriesMetricNewKYCAccounts: {
+				table:           "account_status_changes",
+				timestampColumn: "timestamp",
+				valueColumn:     "COUNT(DISTINCT address)",
+				where:           sq.Eq{"account_status_change_id": entities.AccountStatusChangeIDKYCGranted},
+			},
+			entities.TimeSeriesMetricJobOffersPosted: {
+				table:           "job_offers",
+				timestampColumn: "timestamp",
+				valueColumn:     "COUNT(DISTINCT job_offer_id)",
+			},
+			entities.TimeSeriesMetricJobsCompleted: {
+				table:           "job_status_changes",
+				timestampColumn: "timestamp",
+				valueColumn:     "COUNT(DISTINCT job_id)",
+				where:           sq.Eq{"job_status_id": entities.JobStatusIDDone},
+			},
+		},
+	}
+}
+
+// CalculateTimeSeries aggregates the metric per period starting from the truncated timestamp, periods without values are omitted
+func (r *stats) CalculateTimeSeries(metric entities.TimeSeriesMetric, interval entities.TimeSeriesInterval, from, to *time.Time) ([]entities.TimeSeriesPoint, error) {
+	source, ok := r.timeSeriesSources[metric]
+	if !ok {
+		return nil, fmt.Errorf("unsupported time series metric %s", metric)
+	}
+
+	periodStart, err := truncateToInterval(source.timestampColumn, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := []string{
+		periodStart + " as period_start",
+		"CAST(COALESCE(" + source.valueColumn + ", 0) AS UNSIGNED) as value",
+	}
+	groupBy := []string{"period_start"}
+	if source.groupColumn != "" {
+		columns = append(columns, source.groupColumn+" as voting_type_id")
+		groupBy = append(groupBy, source.groupColumn)
+	}
+
+	queryBuilder := query.Select(columns...).
+		From(source.table).
+		GroupBy(groupBy...).
+		OrderBy(groupBy...)
+
+	if source.where != nil {
+		queryBuilder = queryBuilder.Where(source.where)
+	}
+
+	if from != nil {
+		queryBuilder = queryBuilder.Where(sq.GtOrEq{source.timestampColumn: *from})
+	}
+
+	if to != nil {
+		queryBuilder = queryBuilder.Where(sq.Lt{source.timestampColumn: *to})
+	}
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]entities.TimeSeriesPoint, 0)
+	if err := r.conn.Select(&points, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return points, nil
+}
+
+func truncateToInterval(column string, interval entities.TimeSeriesInterval) (string, error) {
+	switch interval {
+	case entities.TimeSeriesIntervalDay:
+		return fmt.Sprintf("DATE(%s)", column), nil
+	case entities.TimeSeriesIntervalWeek:
+		return fmt.Sprintf("DATE(DATE_SUB(%s, INTERVAL WEEKDAY(%s) DAY))", column, column), nil
+	case entities.TimeSeriesIntervalMonth:
+		return fmt.Sprintf("DATE(DATE_FORMAT(%s, '%%Y-%%m-01'))", column), nil
+	}
+
+	return "", fmt.Errorf("unsupported time series interval %s", interval)
+}
+
+func (r *stats) CountVotingParticipation(filters map[string]interface{}) (uint64, error) {
+	queryBuilder := query.Select("COUNT(*)").
+		From("votings").
+		FilterBy(filters, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+
+	row := r.conn.QueryRow(sql, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *stats) FindVotingParticipation(params *pagination.Params, filters map[string]interface{}) ([]entities.VotingParticipation, error) {
+	queryBuilder := query.Select(
+		"voting_id",
+		"voting_type_id",
+		"total_onboarded",
+		"informal_voting_result",
+		"formal_voting_result",
+		"informal_votes_number",
+		"formal_votes_number",
+		"informal_votes_number * 100 / NULLIF(total_onboarded, 0) as informal_turnout_percent",
+		"formal_votes_number * 100 / NULLIF(total_onboarded, 0) as formal_turnout_percent",
+		"informal_staked_reputation",
+		"formal_staked_reputation",
+		"informal_staked_reputation * 100 / NULLIF(informal_total_reputation, 0) as informal_staked_reputation_percent",
+		"formal_staked_reputation * 100 / NULLIF(formal_total_reputation, 0) as formal_staked_reputation_percent",
+		"both_stages_voters_number",
+		"switched_voters_number",
+		"switched_voters_number * 100 / NULLIF(both_stages_voters_number, 0) as switch_rate_percent",
+	).
+		From(fmt.Sprintf("(%s) participation", votingParticipationQuery)).
+		FilterBy(filters, r.indexedFields).
+		Paginate(params, r.indexedFields)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	participation := make([]entities.VotingParticipation, 0)
+	if err := r.conn.Select(&participation, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return participation, nil
+}
+
+// CalculateVotingTypeParticipation aggregates participation of not canceled Votings per VotingTypeID
+func (r *stats) CalculateVotingTypeParticipation() ([]entities.VotingTypeParticipation, error) {
+	queryBuilder := query.Select(
+		"voting_type_id",
+		"COUNT(*) as votings_number",
+		"CAST(SUM(informal_voting_result IS NOT NULL) AS UNSIGNED) as informal_ended_number",
+		fmt.Sprintf("CAST(SUM(informal_voting_result = %d) AS UNSIGNED) as informal_quorum_missed_number", entities.VotingResultQuorumNotReached),
+		fmt.Sprintf("SUM(informal_voting_result = %d) * 100 / NULLIF(SUM(informal_voting_result IS NOT NULL), 0) as informal_quorum_missed_percent", entities.VotingResultQuorumNotReached),
+		"CAST(SUM(formal_voting_result IS NOT NULL) AS UNSIGNED) as formal_ended_number",
+		fmt.Sprintf("CAST(SUM(formal_voting_result = %d) AS UNSIGNED) as formal_quorum_missed_number", entities.VotingResultQuorumNotReached),
+		fmt.Sprintf("SUM(formal_voting_result = %d) * 100 / NULLIF(SUM(formal_voting_result IS NOT NULL), 0) as formal_quorum_missed_percent", entities.VotingResultQuorumNotReached),
+		"AVG(IF(informal_voting_result IS NOT NULL, informal_votes_number * 100 / NULLIF(total_onboarded, 0), NULL)) as avg_informal_turnout_percent",
+		"AVG(IF(formal_voting_result IS NOT NULL, formal_votes_number * 100 / NULLIF(total_onboarded, 0), NULL)) as avg_formal_turnout_percent",
+		"AVG(IF(informal_voting_result IS NOT NULL, informal_staked_reputation * 100 / NULLIF(informal_total_reputation, 0), NULL)) as avg_informal_staked_reputation_percent",
+		"AVG(IF(formal_voting_result IS NOT NULL, formal_staked_reputation * 100 / NULLIF(formal_total_reputation, 0), NULL)) as avg_formal_staked_reputation_percent",
+		"SUM(switched_voters_number) * 100 / NULLIF(SUM(both_stages_voters_number), 0) as switch_rate_percent",
+	).
+		From(fmt.Sprintf("(%s) participation", votingParticipationQuery)).
+		Where(sq.Eq{"is_canceled": false}).
+		GroupBy("voting_type_id").
+		OrderBy("voting_type_id")
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	participation := make([]entities.VotingTypeParticipation, 0)
+	if err := r.conn.Select(&participation, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return participation, nil
+}
+
+// votingParticipationQuery selects per Voting stage tallies of not canceled ballots, reputation staked by VAs
+// and the total reputation at the end of the stage. The total is the sum of the supply changes only:
+// stake and unstake legs move the reputation between contracts and BidEscrow tracks a single leg of them
+var votingParticipationQuery = fmt.Sprintf(`
+	SELECT
+		votings.voting_id,
+		votings.voting_type_id,
+		votings.is_canceled,
+		votings.config_total_onboarded as total_onboarded,
+		votings.informal_voting_result,
+		votings.formal_voting_result,
+		COALESCE(tallies.informal_votes_number, 0) as informal_votes_number,
+		COALESCE(tallies.formal_votes_number, 0) as formal_votes_number,
+		COALESCE(tallies.informal_staked_reputation, 0) as informal_staked_reputation,
+		COALESCE(tallies.formal_staked_reputation, 0) as formal_staked_reputation,
+		(SELECT COALESCE(SUM(amount), 0) FROM reputation_changes
+			WHERE reputation_changes.reason IN (%[2]d, %[3]d, %[4]d, %[5]d)
+				AND reputation_changes.timestamp <= votings.informal_voting_ends_at) as informal_total_reputation,
+		(SELECT COALESCE(SUM(amount), 0) FROM reputation_changes
+			WHERE reputation_changes.reason IN (%[2]d, %[3]d, %[4]d, %[5]d)
+				AND reputation_changes.timestamp <= COALESCE(votings.formal_voting_ends_at, votings.informal_voting_ends_at)) as formal_total_reputation,
+		COALESCE(switches.both_stages_voters_number, 0) as both_stages_voters_number,
+		COALESCE(switches.switched_voters_number, 0) as switched_voters_number
+	FROM votings
+		LEFT JOIN (
+			SELECT
+				voting_id,
+				CAST(SUM(is_formal = 0) AS UNSIGNED) as informal_votes_number,
+				CAST(SUM(is_formal = 1) AS UNSIGNED) as formal_votes_number,
+				CAST(SUM(IF(is_formal = 0 AND stake_type_id = %[1]d, amount, 0)) AS UNSIGNED) as informal_staked_reputation,
+				CAST(SUM(IF(is_formal = 1 AND stake_type_id = %[1]d, amount, 0)) AS UNSIGNED) as formal_staked_reputation
+			FROM votes
+			WHERE is_canceled = 0
+			GROUP BY voting_id
+		) tallies ON tallies.voting_id = votings.voting_id
+		LEFT JOIN (
+			SELECT
+				informal.voting_id,
+				COUNT(*) as both_stages_voters_number,
+				CAST(SUM(informal.is_in_favour <> formal.is_in_favour) AS UNSIGNED) as switched_voters_number
+			FROM votes informal
+				JOIN votes formal ON formal.voting_id = informal.voting_id AND formal.address = informal.address
+					AND formal.is_formal = 1 AND formal.is_canceled = 0
+			WHERE informal.is_formal = 0 AND informal.is_canceled = 0
+			GROUP BY informal.voting_id
+		) switches ON switches.voting_id = votings.voting_id`,
+	entities.StakeTypeIDReputation,
+	entities.ReputationChangeReasonMinted,
+	entities.ReputationChangeReasonBurned,
+	entities.ReputationChangeReasonVotingGained,
+	entities.ReputationChangeReasonVotingLost,
+)
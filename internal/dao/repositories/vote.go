@@ -1,6 +1,9 @@
 package repositories
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/make-software/casper-go-sdk/casper"
 
 	"casper-dao-middleware/internal/dao/entities"
@@ -19,7 +22,10 @@ type Vote interface {
 	Count(filters map[string]interface{}) (uint64, error)
 	Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.Vote, error)
 	CountVotesNumberForVotings(votingIDs []uint32) (map[uint32]uint32, error)
-	UpdateIsCanceled(votingID uint32, address casper.Hash, isCanceled bool) error
+	CalculateStakeTalliesForVotings(votingIDs []uint32) (map[uint32][]entities.VotingStakeTally, error)
+	CalculateStageTally(votingID uint32, isFormal bool) (*entities.VotingStageTally, error)
+	UpdateIsCanceled(votingID uint32, address casper.Hash, isCanceled bool, canceledAt *time.Time) error
+	UpdateOutcomes(votingID uint32, isFormal bool, outcomes []entities.VoteOutcome) error
 }
 
 type vote struct {
@@ -31,9 +37,10 @@ func NewVote(conn *sqlx.DB) *vote {
 	return &vote{
 		conn: conn,
 		indexedFields: map[string]struct{}{
-			"address":   {},
-			"voting_id": {},
-			"is_formal": {},
+			"address":       {},
+			"voting_id":     {},
+			"is_formal":     {},
+			"stake_type_id": {},
 		},
 	}
 }
@@ -46,6 +53,7 @@ func (r *vote) Save(vote *entities.Vote) error {
 			"voting_id",
 			"address",
 			"amount",
+			"stake_type_id",
 			"is_in_favour",
 			"is_canceled",
 			"is_formal",
@@ -56,6 +64,7 @@ func (r *vote) Save(vote *entities.Vote) error {
 			vote.VotingID,
 			vote.Address,
 			vote.Amount,
+			vote.StakeTypeID,
 			vote.IsInFavor,
 			vote.IsCanceled,
 			vote.IsFormal,
@@ -141,9 +150,66 @@ func (r *vote) CountVotesNumberForVotings(votingIDs []uint32) (map[uint32]uint32
 	return result, nil
 }
 
-func (r *vote) UpdateIsCanceled(votingID uint32, address casper.Hash, isCanceled bool) error {
+// CalculateStakeTalliesForVotings sums not canceled ballots per voting stage keeping reputation and CSPR stakes apart
+func (r *vote) CalculateStakeTalliesForVotings(votingIDs []uint32) (map[uint32][]entities.VotingStakeTally, error) {
+	queryBuilder := query.Select(
+		"voting_id",
+		"is_formal",
+		"stake_type_id",
+		"CAST(COALESCE(SUM(IF(is_in_favour = 1, amount, 0)), 0) AS UNSIGNED) as in_favor_amount",
+		"CAST(COALESCE(SUM(IF(is_in_favour = 0, amount, 0)), 0) AS UNSIGNED) as against_amount",
+		"COUNT(*) as votes_number",
+	).
+		From("votes").
+		Where(sq.Eq{"voting_id": votingIDs, "is_canceled": false}).
+		GroupBy("voting_id", "is_formal", "stake_type_id")
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	tallies := make([]entities.VotingStakeTally, 0)
+	if err := r.conn.Select(&tallies, sql, args...); err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint32][]entities.VotingStakeTally)
+	for _, tally := range tallies {
+		result[tally.VotingID] = append(result[tally.VotingID], tally)
+	}
+
+	return result, nil
+}
+
+// CalculateStageTally sums not canceled ballots of the voting stage, only reputation stakes are summed as the contract compares them
+func (r *vote) CalculateStageTally(votingID uint32, isFormal bool) (*entities.VotingStageTally, error) {
+	queryBuilder := query.Select(
+		"CAST(COALESCE(SUM(is_in_favour = 1), 0) AS UNSIGNED) as votes_in_favor",
+		"CAST(COALESCE(SUM(is_in_favour = 0), 0) AS UNSIGNED) as votes_against",
+		fmt.Sprintf("CAST(COALESCE(SUM(IF(is_in_favour = 1 AND stake_type_id = %d, amount, 0)), 0) AS UNSIGNED) as stake_in_favor", entities.StakeTypeIDReputation),
+		fmt.Sprintf("CAST(COALESCE(SUM(IF(is_in_favour = 0 AND stake_type_id = %d, amount, 0)), 0) AS UNSIGNED) as stake_against", entities.StakeTypeIDReputation),
+	).
+		From("votes").
+		Where(sq.Eq{"voting_id": votingID, "is_formal": isFormal, "is_canceled": false})
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var tally entities.VotingStageTally
+	if err := r.conn.Get(&tally, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return &tally, nil
+}
+
+func (r *vote) UpdateIsCanceled(votingID uint32, address casper.Hash, isCanceled bool, canceledAt *time.Time) error {
 	queryBuilder := query.Update("votes").
 		Set("is_canceled", isCanceled).
+		Set("canceled_at", canceledAt).
 		Where(sq.Eq{
 			"voting_id": votingID,
 			"address":   address,
@@ -157,3 +223,52 @@ func (r *vote) UpdateIsCanceled(votingID uint32, address casper.Hash, isCanceled
 	_, err = r.conn.Exec(sql, args...)
 	return err
 }
+
+// UpdateOutcomes stores ballots outcome of the ended voting stage, votes without outcome are marked with zero values,
+// canceled ballots are left untouched
+func (r *vote) UpdateOutcomes(votingID uint32, isFormal bool, outcomes []entities.VoteOutcome) error {
+	tx, err := r.conn.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	resetQuery, args, err := query.Update("votes").
+		Set("stake_returned", 0).
+		Set("reputation_earned", 0).
+		Set("reputation_burned", 0).
+		Where(sq.Eq{
+			"voting_id":   votingID,
+			"is_formal":   isFormal,
+			"is_canceled": false,
+		}).ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(resetQuery, args...); err != nil {
+		return err
+	}
+
+	for _, outcome := range outcomes {
+		updateQuery, args, err := query.Update("votes").
+			Set("stake_returned", outcome.StakeReturned).
+			Set("reputation_earned", outcome.ReputationEarned).
+			Set("reputation_burned", outcome.ReputationBurned).
+			Where(sq.Eq{
+				"voting_id":   votingID,
+				"is_formal":   isFormal,
+				"is_canceled": false,
+				"address":     outcome.Address,
+			}).ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(updateQuery, args...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
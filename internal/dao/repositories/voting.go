@@ -1,9 +1,14 @@
 package repositories
 
 import (
+	"database/sql"
+	"time"
+
 	sq "github.com/Masterminds/squirrel"
+	"github.com/make-software/casper-go-sdk/casper"
 
 	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/errors"
 	"casper-dao-middleware/pkg/pagination"
 	"casper-dao-middleware/pkg/query"
 
@@ -20,6 +25,7 @@ type Voting interface {
 	GetByVotingID(votingID uint32) (*entities.Voting, error)
 	Update(voting *entities.Voting) error
 	UpdateIsCanceled(votingID uint32, isCanceled bool) error
+	FindActiveNotVotedBy(address casper.Hash, at time.Time) ([]*entities.Voting, error)
 }
 
 type voting struct {
@@ -50,6 +56,7 @@ func (r *voting) Save(voting *entities.Voting) error {
 			"informal_voting_starts_at",
 			"informal_voting_ends_at",
 			"formal_voting_quorum",
+			"formal_voting_time",
 			"formal_voting_starts_at",
 			"formal_voting_ends_at",
 			"metadata",
@@ -69,6 +76,7 @@ func (r *voting) Save(voting *entities.Voting) error {
 			voting.InformalVotingStartsAt,
 			voting.InformalVotingEndsAt,
 			voting.FormalVotingQuorum,
+			voting.FormalVotingTime,
 			voting.FormalVotingStartsAt,
 			voting.FormalVotingEndsAt,
 			voting.Metadata,
@@ -93,9 +101,9 @@ func (r *voting) Save(voting *entities.Voting) error {
 }
 
 func (r *voting) Count(filters map[string]interface{}) (uint64, error) {
-	queryBuilder := query.Select("COUNT(*)").
+	queryBuilder := r.filterByJobOffer(query.Select("COUNT(*)").
 		From("votings").
-		FilterBy(filters, r.indexedFields)
+		FilterBy(filters, r.indexedFields), filters)
 
 	sql, args, err := queryBuilder.ToSql()
 	if err != nil {
@@ -113,9 +121,9 @@ func (r *voting) Count(filters map[string]interface{}) (uint64, error) {
 }
 
 func (r *voting) Find(params *pagination.Params, filters map[string]interface{}) ([]*entities.Voting, error) {
-	queryBuilder := query.Select("*").
+	queryBuilder := r.filterByJobOffer(query.Select("*").
 		From("votings").
-		FilterBy(filters, r.indexedFields).
+		FilterBy(filters, r.indexedFields), filters).
 		Paginate(params, r.indexedFields)
 
 	sql, args, err := queryBuilder.ToSql()
@@ -131,6 +139,45 @@ func (r *voting) Find(params *pagination.Params, filters map[string]interface{})
 	return votings, nil
 }
 
+// FindActiveNotVotedBy finds not canceled Votings with the informal or formal stage active at the given time
+// where the address has not voted in the active stage yet
+func (r *voting) FindActiveNotVotedBy(address casper.Hash, at time.Time) ([]*entities.Voting, error) {
+	queryBuilder := query.Select("*").
+		From("votings").
+		Where(sq.Eq{"is_canceled": false}).
+		Where(sq.Or{
+			sq.And{
+				sq.LtOrEq{"informal_voting_starts_at": at},
+				sq.Gt{"informal_voting_ends_at": at},
+				sq.Eq{"informal_voting_result": nil},
+			},
+			// formal voting follows the ended informal one unless the quorum is not reached
+			sq.And{
+				sq.LtOrEq{"formal_voting_starts_at": at},
+				sq.Gt{"formal_voting_ends_at": at},
+				sq.NotEq{"informal_voting_result": nil},
+				sq.NotEq{"informal_voting_result": entities.VotingResultQuorumNotReached},
+				sq.Eq{"formal_voting_result": nil},
+			},
+		}).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM votes
+			WHERE votes.voting_id = votings.voting_id AND votes.address = ? AND votes.is_formal = (votings.informal_voting_result IS NOT NULL)
+		)`, address)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	votings := make([]*entities.Voting, 0)
+	if err := r.conn.Select(&votings, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return votings, nil
+}
+
 func (r *voting) GetByVotingID(votingID uint32) (*entities.Voting, error) {
 	queryBuilder := query.Select("*").
 		From("votings").
@@ -138,13 +185,16 @@ func (r *voting) GetByVotingID(votingID uint32) (*entities.Voting, error) {
 			"voting_id": votingID,
 		})
 
-	sql, args, err := queryBuilder.ToSql()
+	sqlQuery, args, err := queryBuilder.ToSql()
 	if err != nil {
 		return nil, err
 	}
 
 	var voting entities.Voting
-	if err := r.conn.Get(&voting, sql, args...); err != nil {
+	if err := r.conn.Get(&voting, sqlQuery, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("not found voting by voting_id")
+		}
 		return nil, err
 	}
 
@@ -192,3 +242,15 @@ func (r *voting) UpdateIsCanceled(votingID uint32, isCanceled bool) error {
 	_, err = r.conn.Exec(sql, args...)
 	return err
 }
+
+// filterByJobOffer filters BidEscrow votings by job_offer_id stored in the voting metadata
+func (r *voting) filterByJobOffer(queryBuilder *query.SelectBuilder, filters map[string]interface{}) *query.SelectBuilder {
+	jobOfferIDs, ok := filters["job_offer_id"]
+	if !ok {
+		return queryBuilder
+	}
+
+	return queryBuilder.
+		Where(sq.Eq{"voting_type_id": entities.VotingTypeBidEscrow}).
+		Where(sq.Eq{"CAST(JSON_EXTRACT(metadata, '$.job_offer_id') AS UNSIGNED)": jobOfferIDs})
+}
@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/query"
+)
+
+// VotingConfig DB table interface
+//
+//go:generate mockgen -destination=../tests/mocks/voting_config_mock.go -package=mocks -source=./voting_config.go VotingConfig
+type VotingConfig interface {
+	Save(config *entities.VotingConfig) error
+	FindByVotingIDs(votingIDs []uint32) ([]entities.VotingConfig, error)
+}
+
+type votingConfig struct {
+	conn *sqlx.DB
+}
+
+func NewVotingConfig(conn *sqlx.DB) VotingConfig {
+	return &votingConfig{
+		conn: conn,
+	}
+}
+
+func (r *votingConfig) Save(config *entities.VotingConfig) error {
+	queryBuilder := query.Insert("voting_configs").
+		Options("IGNORE").
+		Columns(
+			"voting_id",
+			"informal_quorum",
+			"informal_voting_time",
+			"formal_quorum",
+			"formal_voting_time",
+			"total_onboarded",
+			"double_time_between_votings",
+			"voting_clearness_delta",
+			"time_between_informal_and_formal_voting",
+			"settings",
+			"timestamp",
+		).
+		Values(
+			config.VotingID,
+			config.InformalQuorum,
+			config.InformalVotingTime,
+			config.FormalQuorum,
+			config.FormalVotingTime,
+			config.TotalOnboarded,
+			config.DoubleTimeBetweenVotings,
+			config.VotingClearnessDelta,
+			config.TimeBetweenInformalAndFormalVoting,
+			config.Settings,
+			config.Timestamp,
+		)
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *votingConfig) FindByVotingIDs(votingIDs []uint32) ([]entities.VotingConfig, error) {
+	queryBuilder := query.Select("*").
+		From("voting_configs").
+		Where(sq.Eq{"voting_id": votingIDs})
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make([]entities.VotingConfig, 0)
+	if err := r.conn.Select(&configs, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return configs, nil
+}
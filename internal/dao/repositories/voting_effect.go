@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/query"
+)
+
+// VotingEffect DB table interface
+//
+//go:generate mockgen -destination=../tests/mocks/voting_effect_mock.go -package=mocks -source=./voting_effect.go VotingEffect
+type VotingEffect interface {
+	Save(effect *entities.VotingEffect) error
+	FindByVotingIDs(votingIDs []uint32) ([]entities.VotingEffect, error)
+}
+
+type votingEffect struct {
+	conn *sqlx.DB
+}
+
+func NewVotingEffect(conn *sqlx.DB) VotingEffect {
+	return &votingEffect{
+		conn: conn,
+	}
+}
+
+func (r *votingEffect) Save(effect *entities.VotingEffect) error {
+	queryBuilder := query.Insert("voting_effects").
+		Options("IGNORE").
+		Columns(
+			"voting_id",
+			"voting_effect_type_id",
+			"address",
+			"amount",
+			"token_id",
+			"deploy_hash",
+			"transform_id",
+			"timestamp",
+		).
+		Values(
+			effect.VotingID,
+			effect.VotingEffectTypeID,
+			effect.Address,
+			effect.Amount,
+			effect.TokenID,
+			effect.DeployHash,
+			effect.TransformID,
+			effect.Timestamp,
+		)
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindByVotingIDs finds effects of the votings in the order they were executed
+func (r *votingEffect) FindByVotingIDs(votingIDs []uint32) ([]entities.VotingEffect, error) {
+	queryBuilder := query.Select("*").
+		From("voting_effects").
+		Where(sq.Eq{"voting_id": votingIDs}).
+		OrderBy("voting_id", "transform_id")
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	effects := make([]entities.VotingEffect, 0)
+	if err := r.conn.Select(&effects, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return effects, nil
+}
@@ -0,0 +1,14 @@
+create table reputation_balances
+(
+    address               binary(32) not null,
+    contract_package_hash binary(32) not null,
+    amount                bigint     not null,
+
+    primary key (address, contract_package_hash)
+) ENGINE = InnoDB
+  default CHARSET = utf8;
+
+insert into reputation_balances (address, contract_package_hash, amount)
+select address, contract_package_hash, sum(amount)
+from reputation_changes
+group by address, contract_package_hash;
@@ -0,0 +1,4 @@
+alter table votes
+    drop column stake_returned,
+    drop column reputation_earned,
+    drop column reputation_burned;
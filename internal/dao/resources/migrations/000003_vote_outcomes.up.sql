@@ -0,0 +1,4 @@
+alter table votes
+    add column stake_returned    bigint unsigned null after is_formal,
+    add column reputation_earned bigint unsigned null after stake_returned,
+    add column reputation_burned bigint unsigned null after reputation_earned;
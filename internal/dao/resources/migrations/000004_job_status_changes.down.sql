@@ -0,0 +1 @@
+drop table if exists job_status_changes;
@@ -0,0 +1,21 @@
+create table job_status_changes
+(
+    job_id        int unsigned not null,
+    bid_id        int unsigned not null,
+    job_status_id tinyint unsigned not null,
+    caller        binary(32) null,
+    result        text null,
+    deploy_hash   binary(32) not null,
+    timestamp     datetime not null,
+    is_restored   tinyint(1) not null default 0,
+
+    primary key (job_id, job_status_id, deploy_hash),
+    key (bid_id)
+) ENGINE = InnoDB
+  default CHARSET = utf8;
+
+-- only job creation can be restored for already tracked jobs, the rest of transitions were overwritten in place;
+-- jobs.timestamp is the processing time rather than the deploy time, so restored rows are flagged
+insert into job_status_changes (job_id, bid_id, job_status_id, caller, result, deploy_hash, timestamp, is_restored)
+select job_id, bid_id, 1, null, null, deploy_hash, timestamp, 1
+from jobs;
@@ -0,0 +1,19 @@
+create table cspr_flows
+(
+    job_offer_id      int unsigned not null,
+    bid_id            int unsigned not null,
+    job_id            int unsigned null,
+    cspr_flow_type_id tinyint unsigned not null,
+    sender            binary(32) null,
+    recipient         binary(32) null,
+    amount            bigint unsigned not null,
+    sequence          smallint unsigned not null,
+    deploy_hash       binary(32) not null,
+    timestamp         datetime not null,
+
+    primary key (deploy_hash, bid_id, cspr_flow_type_id, sequence),
+    key (job_id),
+    key (sender),
+    key (recipient)
+) ENGINE = InnoDB
+  default CHARSET = utf8;
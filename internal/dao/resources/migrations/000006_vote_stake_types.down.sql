@@ -0,0 +1,2 @@
+alter table votes
+    drop column stake_type_id;
@@ -0,0 +1,10 @@
+alter table votes
+    add column stake_type_id tinyint unsigned not null default 1 after amount;
+
+-- Non VA voters of BidEscrow votings stake CSPR, VA status at the moment of migration is the best available approximation
+update votes
+    join votings on votings.voting_id = votes.voting_id
+    join accounts on accounts.hash = votes.address
+set votes.stake_type_id = 2
+where votings.voting_type_id = 8
+  and accounts.is_va = 0;
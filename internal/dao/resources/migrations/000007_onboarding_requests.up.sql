@@ -0,0 +1,48 @@
+create table onboarding_requests
+(
+    voting_id                    int unsigned not null,
+    requester                    binary(32) not null,
+    reason                       text not null,
+    cspr_deposit                 bigint unsigned not null,
+    onboarding_request_status_id tinyint unsigned not null,
+    deposit_status_id            tinyint unsigned null,
+    va_token_id                  bigint unsigned null,
+    va_granted_deploy_hash       binary(32) null,
+    deploy_hash                  binary(32) not null,
+    timestamp                    datetime not null,
+    resolved_at                  datetime null,
+
+    primary key (voting_id),
+    key (requester)
+) ENGINE = InnoDB
+  default CHARSET = utf8;
+
+-- restore already tracked requests from the onboarding votings metadata, the VA grant can not be restored;
+-- resolved requests take the end of the last voting stage as the resolve time, the cancel time is not stored
+insert into onboarding_requests (voting_id, requester, reason, cspr_deposit, onboarding_request_status_id,
+                                 deposit_status_id, deploy_hash, timestamp, resolved_at)
+select voting_id,
+       creator,
+       coalesce(json_unquote(json_extract(metadata, '$.reason')), ''),
+       cast(coalesce(json_unquote(json_extract(metadata, '$.cspr_deposit')), '0') as unsigned),
+       case
+           when is_canceled = 1 then 4
+           when formal_voting_result = 0 then 2
+           when formal_voting_result is not null or informal_voting_result = 2 then 3
+           else 1
+           end,
+       case
+           when is_canceled = 1 or formal_voting_result = 0 or formal_voting_result = 2 or
+                (formal_voting_result is null and informal_voting_result = 2) then 1
+           when formal_voting_result is not null then 2
+           end,
+       deploy_hash,
+       informal_voting_starts_at,
+       case
+           when formal_voting_result is not null then formal_voting_ends_at
+           when informal_voting_result = 2 then informal_voting_ends_at
+           when is_canceled = 1 and informal_voting_result is null then informal_voting_ends_at
+           when is_canceled = 1 then coalesce(formal_voting_ends_at, informal_voting_ends_at)
+           end
+from votings
+where voting_type_id = 6;
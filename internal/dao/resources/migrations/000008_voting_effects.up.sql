@@ -0,0 +1,15 @@
+create table voting_effects
+(
+    voting_id             int unsigned not null,
+    voting_effect_type_id tinyint unsigned not null,
+    address               binary(32) not null,
+    amount                bigint unsigned null,
+    token_id              bigint unsigned null,
+    deploy_hash           binary(32) not null,
+    transform_id          int unsigned not null,
+    timestamp             datetime not null,
+
+    primary key (deploy_hash, transform_id),
+    key (voting_id)
+) ENGINE = InnoDB
+  default CHARSET = utf8;
@@ -0,0 +1,35 @@
+create table slashings
+(
+    voting_id            int unsigned not null,
+    address_to_slash     binary(32) not null,
+    slash_ratio          int unsigned not null,
+    creator              binary(32) not null,
+    slashing_status_id   tinyint unsigned not null,
+    reputation_burned    bigint unsigned null,
+    stakes_returned      bigint unsigned null,
+    deploy_hash          binary(32) not null,
+    resolved_deploy_hash binary(32) null,
+    timestamp            datetime not null,
+    resolved_at          datetime null,
+
+    primary key (voting_id),
+    key (address_to_slash)
+) ENGINE = InnoDB
+  default CHARSET = utf8;
+
+-- restore already tracked slashings from the slashing votings metadata, the slashed amounts can not be restored
+insert into slashings (voting_id, address_to_slash, slash_ratio, creator, slashing_status_id, deploy_hash, timestamp)
+select voting_id,
+       unhex(json_unquote(json_extract(metadata, '$.address_to_slash'))),
+       cast(coalesce(json_unquote(json_extract(metadata, '$.slash_ration')), '0') as unsigned),
+       creator,
+       case
+           when is_canceled = 1 then 4
+           when formal_voting_result = 0 then 2
+           when formal_voting_result is not null or informal_voting_result = 2 then 3
+           else 1
+           end,
+       deploy_hash,
+       informal_voting_starts_at
+from votings
+where voting_type_id = 2;
@@ -0,0 +1,21 @@
+create table setting_changes
+(
+    name            varchar(64) not null,
+    value           varchar(64) not null,
+    activation_time datetime null,
+    effective_at    datetime not null,
+    deploy_hash     binary(32) not null,
+    timestamp       datetime not null,
+
+    primary key (deploy_hash, name),
+    key (name, effective_at)
+) ENGINE = InnoDB
+  default CHARSET = utf8;
+
+-- activation times were parsed from the block time milliseconds as seconds, so the scheduled values could not be kept,
+-- the pending values are restored from the Variable Repository storage by the settings-sync command
+update settings
+set next_value      = null,
+    activation_time = null
+where activation_time is not null
+  and activation_time < '1970-01-02';
@@ -0,0 +1,8 @@
+alter table setting_changes
+    drop column value_type_id,
+    modify value varchar(64) not null;
+
+alter table settings
+    drop column value_type_id,
+    modify value varchar(64) not null,
+    modify next_value varchar(64) null;
@@ -0,0 +1,22 @@
+-- U256 values do not fit 64 characters in decimal representation
+alter table settings
+    modify value varchar(80) not null,
+    modify next_value varchar(80) null,
+    add column value_type_id tinyint unsigned null after value;
+
+alter table setting_changes
+    modify value varchar(80) not null,
+    add column value_type_id tinyint unsigned null after value;
+
+-- numeric types can not be distinguished by the stored value, they are restored by the settings sync on start
+update settings
+set value_type_id = case
+                        when value in ('true', 'false') then 4
+                        when value regexp '^[0-9a-f]{64}$' then 3
+    end;
+
+update setting_changes
+set value_type_id = case
+                        when value in ('true', 'false') then 4
+                        when value regexp '^[0-9a-f]{64}$' then 3
+    end;
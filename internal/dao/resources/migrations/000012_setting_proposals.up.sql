@@ -0,0 +1,36 @@
+create table setting_proposals
+(
+    voting_id                  int unsigned not null,
+    name                       varchar(64) not null,
+    variable_repo_to_edit      binary(32) not null,
+    proposed_value             varchar(80) null,
+    value_type_id              tinyint unsigned null,
+    activation_time            datetime null,
+    setting_proposal_status_id tinyint unsigned not null,
+    deploy_hash                binary(32) not null,
+    timestamp                  datetime not null,
+    resolved_at                datetime null,
+
+    primary key (voting_id),
+    key (name)
+) ENGINE = InnoDB
+  default CHARSET = utf8;
+
+-- restore already tracked proposals from the repo votings metadata,
+-- the proposed value was stored as raw bytes string and can not be decoded
+insert into setting_proposals (voting_id, name, variable_repo_to_edit, activation_time, setting_proposal_status_id,
+                               deploy_hash, timestamp)
+select voting_id,
+       coalesce(json_unquote(json_extract(metadata, '$.key')), ''),
+       unhex(json_unquote(json_extract(metadata, '$.variable_repo_to_edit'))),
+       from_unixtime(nullif(cast(coalesce(json_unquote(json_extract(metadata, '$.activation_time')), '0') as unsigned), 0) / 1000),
+       case
+           when is_canceled = 1 then 4
+           when formal_voting_result = 0 then 2
+           when formal_voting_result is not null or informal_voting_result = 2 then 3
+           else 1
+           end,
+       deploy_hash,
+       informal_voting_starts_at
+from votings
+where voting_type_id = 4;
@@ -0,0 +1,4 @@
+drop table if exists voting_configs;
+
+alter table votings
+    drop column formal_voting_time;
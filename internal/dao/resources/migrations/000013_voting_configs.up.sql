@@ -0,0 +1,40 @@
+create table voting_configs
+(
+    voting_id                               int unsigned not null,
+    informal_quorum                         int unsigned not null,
+    informal_voting_time                    bigint unsigned not null,
+    formal_quorum                           int unsigned not null,
+    formal_voting_time                      bigint unsigned not null,
+    total_onboarded                         bigint unsigned not null,
+    double_time_between_votings             tinyint(1) null,
+    voting_clearness_delta                  bigint unsigned not null,
+    time_between_informal_and_formal_voting bigint unsigned not null,
+    settings                                json null,
+    timestamp                               datetime not null,
+
+    primary key (voting_id)
+) ENGINE = InnoDB
+  default CHARSET = utf8;
+
+alter table votings
+    add column formal_voting_time bigint unsigned not null default 0 after formal_voting_quorum;
+
+-- the formal voting time is known only for the votings with the scheduled formal voting
+update votings
+set formal_voting_time = coalesce(timestampdiff(microsecond, formal_voting_starts_at, formal_voting_ends_at) div 1000, 0);
+
+-- restore the config of already tracked votings from their columns,
+-- the double time flag and the settings snapshot can not be restored
+insert into voting_configs (voting_id, informal_quorum, informal_voting_time, formal_quorum, formal_voting_time,
+                            total_onboarded, voting_clearness_delta, time_between_informal_and_formal_voting,
+                            timestamp)
+select voting_id,
+       informal_voting_quorum,
+       timestampdiff(microsecond, informal_voting_starts_at, informal_voting_ends_at) div 1000,
+       formal_voting_quorum,
+       formal_voting_time,
+       config_total_onboarded,
+       config_voting_clearness_delta,
+       config_time_between_informal_and_formal_voting,
+       informal_voting_starts_at
+from votings;
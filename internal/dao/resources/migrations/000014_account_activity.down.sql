@@ -0,0 +1,7 @@
+drop table if exists account_status_changes;
+
+alter table votes
+    drop column canceled_at;
+
+alter table reputation_changes
+    drop column id;
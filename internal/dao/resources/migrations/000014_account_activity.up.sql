@@ -0,0 +1,31 @@
+alter table votes
+    add column canceled_at datetime null after is_canceled;
+
+-- the activity timeline refers to the reputation change by its id
+alter table reputation_changes
+    add column id bigint unsigned not null auto_increment first,
+    add unique key (id);
+
+create table account_status_changes
+(
+    id                       bigint unsigned not null auto_increment,
+    address                  binary(32) not null,
+    account_status_change_id tinyint unsigned not null,
+    deploy_hash              binary(32) null,
+    timestamp                datetime not null,
+
+    primary key (id),
+    unique key (address, account_status_change_id, deploy_hash)
+) ENGINE = InnoDB
+  default CHARSET = utf8;
+
+-- status changes of already tracked accounts are restored from the accounts state without the deploy hash
+insert into account_status_changes (address, account_status_change_id, deploy_hash, timestamp)
+select hash, 1, null, timestamp
+from accounts
+where is_kyc = 1;
+
+insert into account_status_changes (address, account_status_change_id, deploy_hash, timestamp)
+select hash, 2, null, timestamp
+from accounts
+where is_va = 1;
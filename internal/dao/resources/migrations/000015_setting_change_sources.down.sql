@@ -0,0 +1,9 @@
+delete
+from setting_changes
+where deploy_hash is null;
+
+alter table setting_changes
+    drop key deploy_hash,
+    modify deploy_hash binary(32) not null,
+    add primary key (deploy_hash, name),
+    drop column setting_change_source_id;
@@ -0,0 +1,6 @@
+-- values corrected by the settings sync are recorded without the deploy
+alter table setting_changes
+    add column setting_change_source_id tinyint unsigned not null default 1 after effective_at,
+    drop primary key,
+    modify deploy_hash binary(32) null,
+    add unique key (deploy_hash, name);
@@ -0,0 +1,2 @@
+alter table reputation_changes
+    drop key reputation_supply;
@@ -0,0 +1,3 @@
+-- covers the reputation supply sums as of the moment, which are calculated per voting stage
+alter table reputation_changes
+    add key reputation_supply (reason, timestamp, amount);
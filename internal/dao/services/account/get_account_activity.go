@@ -0,0 +1,37 @@
+package account
+
+import (
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetAccountActivity struct {
+	di.EntityManagerAware
+	di.PaginationParamsAware
+
+	address casper.Hash
+}
+
+func NewGetAccountActivity() *GetAccountActivity {
+	return &GetAccountActivity{}
+}
+
+func (c *GetAccountActivity) SetAddress(address casper.Hash) {
+	c.address = address
+}
+
+func (c *GetAccountActivity) Execute() (*pagination.Result, error) {
+	count, err := c.GetEntityManager().AccountActivityRepository().Count(c.address)
+	if err != nil {
+		return nil, err
+	}
+
+	activities, err := c.GetEntityManager().AccountActivityRepository().Find(c.GetPaginationParams(), c.address)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, activities), nil
+}
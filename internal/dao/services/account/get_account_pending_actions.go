@@ -0,0 +1,125 @@
+package account
+
+import (
+	"sort"
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetAccountPendingActions struct {
+	di.EntityManagerAware
+
+	address casper.Hash
+}
+
+func NewGetAccountPendingActions() *GetAccountPendingActions {
+	return &GetAccountPendingActions{}
+}
+
+func (c *GetAccountPendingActions) SetAddress(address casper.Hash) {
+	c.address = address
+}
+
+// Execute returns the actions expected from the account ordered by the deadline
+func (c *GetAccountPendingActions) Execute() ([]entities.PendingAction, error) {
+	now := time.Now().UTC()
+
+	actions, err := c.collectVotePendingActions(now)
+	if err != nil {
+		return nil, err
+	}
+
+	jobActions, err := c.collectJobPendingActions(now)
+	if err != nil {
+		return nil, err
+	}
+
+	actions = append(actions, jobActions...)
+	sort.SliceStable(actions, func(i, j int) bool {
+		return actions[i].Deadline.Before(actions[j].Deadline)
+	})
+
+	return actions, nil
+}
+
+// only VAs are able to vote, the account is not stored until its KYC or VA status is tracked
+func (c *GetAccountPendingActions) collectVotePendingActions(now time.Time) ([]entities.PendingAction, error) {
+	accounts, err := c.GetEntityManager().AccountRepository().Find(&pagination.Params{Page: 1, PageSize: 1}, map[string]interface{}{
+		"hash": c.address,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accounts) == 0 || !accounts[0].IsVA {
+		return make([]entities.PendingAction, 0), nil
+	}
+
+	votings, err := c.GetEntityManager().VotingRepository().FindActiveNotVotedBy(c.address, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return newVotePendingActions(votings), nil
+}
+
+func (c *GetAccountPendingActions) collectJobPendingActions(now time.Time) ([]entities.PendingAction, error) {
+	filters := map[string]interface{}{
+		"job_status_id": entities.JobStatusIDCreated,
+	}
+
+	count, err := c.GetEntityManager().JobRepository().CountByAccount(c.address, nil, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := c.GetEntityManager().JobRepository().FindByAccount(&pagination.Params{Page: 1, PageSize: count}, c.address, nil, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return newJobPendingActions(c.address, jobs, now), nil
+}
+
+// newVotePendingActions expects the active votings the VA has not voted in, the stage is detected by the informal voting result
+func newVotePendingActions(votings []*entities.Voting) []entities.PendingAction {
+	actions := make([]entities.PendingAction, 0, len(votings))
+	for _, voting := range votings {
+		// formal voting follows the ended informal one
+		if voting.InformalVotingResult != nil {
+			if voting.FormalVotingEndsAt != nil {
+				actions = append(actions, entities.NewVotePendingAction(voting.VotingID, true, *voting.FormalVotingEndsAt))
+			}
+			continue
+		}
+
+		actions = append(actions, entities.NewVotePendingAction(voting.VotingID, false, voting.InformalVotingEndsAt))
+	}
+
+	return actions
+}
+
+// newJobPendingActions expects the created jobs of the account, the worker has to submit them
+// and the job poster can cancel them once the finish time is missed
+func newJobPendingActions(address casper.Hash, jobs []*entities.Job, now time.Time) []entities.PendingAction {
+	actions := make([]entities.PendingAction, 0, len(jobs))
+	for _, job := range jobs {
+		finishTime := time.Unix(int64(job.FinishTime), 0).UTC()
+
+		if job.Worker.ToHex() == address.ToHex() {
+			actions = append(actions, entities.NewJobPendingAction(entities.PendingActionTypeIDSubmitJob, job.JobID, finishTime))
+			continue
+		}
+
+		if finishTime.Before(now) {
+			actions = append(actions, entities.NewJobPendingAction(entities.PendingActionTypeIDCancelJob, job.JobID, finishTime))
+		}
+	}
+
+	return actions
+}
@@ -0,0 +1,84 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+	"github.com/stretchr/testify/assert"
+
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+func TestNewVotePendingActions(t *testing.T) {
+	informalVotingEndsAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	formalVotingEndsAt := informalVotingEndsAt.Add(time.Hour)
+	informalVotingResult := entities.VotingResultInFavor
+
+	votings := []*entities.Voting{
+		{
+			VotingID:             1,
+			InformalVotingEndsAt: informalVotingEndsAt,
+		},
+		{
+			VotingID:             2,
+			InformalVotingEndsAt: informalVotingEndsAt,
+			InformalVotingResult: &informalVotingResult,
+			FormalVotingEndsAt:   &formalVotingEndsAt,
+		},
+		{
+			// formal voting period is not known yet
+			VotingID:             3,
+			InformalVotingEndsAt: informalVotingEndsAt,
+			InformalVotingResult: &informalVotingResult,
+		},
+	}
+
+	actions := newVotePendingActions(votings)
+
+	assert.Equal(t, 2, len(actions))
+
+	assert.Equal(t, entities.PendingActionTypeIDVote, actions[0].PendingActionTypeID)
+	assert.Equal(t, uint32(1), *actions[0].VotingID)
+	assert.False(t, *actions[0].IsFormal)
+	assert.Equal(t, informalVotingEndsAt, actions[0].Deadline)
+
+	assert.Equal(t, entities.PendingActionTypeIDVote, actions[1].PendingActionTypeID)
+	assert.Equal(t, uint32(2), *actions[1].VotingID)
+	assert.True(t, *actions[1].IsFormal)
+	assert.Equal(t, formalVotingEndsAt, actions[1].Deadline)
+}
+
+func TestNewJobPendingActions(t *testing.T) {
+	worker := casper.Hash{1}
+	jobPoster := casper.Hash{2}
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	missedFinishTime := uint64(now.Add(-time.Hour).Unix())
+	futureFinishTime := uint64(now.Add(time.Hour).Unix())
+
+	jobs := []*entities.Job{
+		{JobID: 1, Worker: worker, JobPoster: jobPoster, FinishTime: futureFinishTime},
+		{JobID: 2, Worker: worker, JobPoster: jobPoster, FinishTime: missedFinishTime},
+	}
+
+	t.Run("worker submits the jobs", func(t *testing.T) {
+		actions := newJobPendingActions(worker, jobs, now)
+
+		assert.Equal(t, 2, len(actions))
+		for i, action := range actions {
+			assert.Equal(t, entities.PendingActionTypeIDSubmitJob, action.PendingActionTypeID)
+			assert.Equal(t, jobs[i].JobID, *action.JobID)
+			assert.Equal(t, time.Unix(int64(jobs[i].FinishTime), 0).UTC(), action.Deadline)
+		}
+	})
+
+	t.Run("job poster cancels the jobs with missed finish time", func(t *testing.T) {
+		actions := newJobPendingActions(jobPoster, jobs, now)
+
+		assert.Equal(t, 1, len(actions))
+		assert.Equal(t, entities.PendingActionTypeIDCancelJob, actions[0].PendingActionTypeID)
+		assert.Equal(t, uint32(2), *actions[0].JobID)
+		assert.Equal(t, time.Unix(int64(missedFinishTime), 0).UTC(), actions[0].Deadline)
+	})
+}
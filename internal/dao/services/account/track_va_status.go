@@ -12,6 +12,7 @@ import (
 type TrackVATransfer struct {
 	di.EntityManagerAware
 	di.CESEventAware
+	di.DeployProcessedEventAware
 }
 
 func NewTrackVATransfer() *TrackVATransfer {
@@ -24,11 +25,29 @@ func (s *TrackVATransfer) Execute() error {
 		return err
 	}
 
-	if event.To == nil {
-		return errors.New("expected not nil transfer receiver")
+	var (
+		account        entities.Account
+		statusChangeID entities.AccountStatusChangeID
+	)
+
+	switch {
+	case event.To != nil:
+		account = entities.NewAccount(*event.To.ToHash(), false, true, time.Now().UTC())
+		statusChangeID = entities.AccountStatusChangeIDVAGranted
+	// the burned token revokes the status of its owner
+	case event.From != nil:
+		account = entities.NewAccount(*event.From.ToHash(), false, false, time.Now().UTC())
+		statusChangeID = entities.AccountStatusChangeIDVARevoked
+	default:
+		return errors.New("expected not nil transfer sender or receiver")
 	}
 
-	account := entities.NewAccount(*event.To.ToHash(), false, true, time.Now().UTC())
+	if err := s.GetEntityManager().AccountRepository().UpsertIsVA(account); err != nil {
+		return err
+	}
+
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+	statusChange := entities.NewAccountStatusChange(account.Hash, statusChangeID, deployProcessed.DeployHash, deployProcessed.Timestamp)
 
-	return s.GetEntityManager().AccountRepository().UpsertIsVA(account)
+	return s.GetEntityManager().AccountStatusChangeRepository().Save(&statusChange)
 }
@@ -0,0 +1,48 @@
+package bid
+
+import (
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetAccountBids struct {
+	di.PaginationParamsAware
+	di.EntityManagerAware
+
+	address  casper.Hash
+	isPicked *bool
+}
+
+func NewGetAccountBids() *GetAccountBids {
+	return &GetAccountBids{}
+}
+
+func (c *GetAccountBids) SetAddress(address casper.Hash) {
+	c.address = address
+}
+
+func (c *GetAccountBids) SetIsPicked(isPicked *bool) {
+	c.isPicked = isPicked
+}
+
+func (c *GetAccountBids) Execute() (*pagination.Result, error) {
+	filters := map[string]interface{}{}
+
+	if c.isPicked != nil {
+		filters["picked_by_job_poster"] = *c.isPicked
+	}
+
+	count, err := c.GetEntityManager().BidRepository().CountByAccount(c.address, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	bids, err := c.GetEntityManager().BidRepository().FindByAccount(c.GetPaginationParams(), c.address, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, bids), nil
+}
@@ -9,20 +9,31 @@ type GetBids struct {
 	di.PaginationParamsAware
 	di.EntityManagerAware
 
-	jobOfferID uint32
+	jobOfferIDs []uint32
+	isPicked    *bool
 }
 
 func NewGetBids() *GetBids {
 	return &GetBids{}
 }
 
-func (c *GetBids) SetJobOfferID(jobOfferID uint32) {
-	c.jobOfferID = jobOfferID
+func (c *GetBids) SetJobOfferIDs(jobOfferIDs []uint32) {
+	c.jobOfferIDs = jobOfferIDs
+}
+
+func (c *GetBids) SetIsPicked(isPicked *bool) {
+	c.isPicked = isPicked
 }
 
 func (c *GetBids) Execute() (*pagination.Result, error) {
-	filters := map[string]interface{}{
-		"job_offer_id": c.jobOfferID,
+	filters := map[string]interface{}{}
+
+	if len(c.jobOfferIDs) != 0 {
+		filters["job_offer_id"] = c.jobOfferIDs
+	}
+
+	if c.isPicked != nil {
+		filters["picked_by_job_poster"] = *c.isPicked
 	}
 
 	count, err := c.GetEntityManager().BidRepository().Count(filters)
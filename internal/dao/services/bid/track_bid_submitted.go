@@ -55,5 +55,26 @@ func (s *TrackBidSubmitted) Execute() error {
 		time.Now().UTC(),
 	)
 
-	return s.GetEntityManager().BidRepository().Save(&bid)
+	if err := s.GetEntityManager().BidRepository().Save(&bid); err != nil {
+		return err
+	}
+
+	if csprStake == nil {
+		return nil
+	}
+
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+	stakeDeposit := entities.NewCSPRFlow(
+		bidSubmitted.JobOfferID,
+		bidSubmitted.BidID,
+		nil,
+		entities.CSPRFlowTypeIDStakeDeposit,
+		&bidSubmitted.Worker,
+		nil,
+		*csprStake,
+		deployProcessed.DeployHash,
+		deployProcessed.Timestamp,
+	)
+
+	return s.GetEntityManager().CSPRFlowRepository().SaveBatch([]entities.CSPRFlow{stakeDeposit})
 }
@@ -10,7 +10,9 @@ import (
 	"casper-dao-middleware/internal/dao/services/bid"
 	"casper-dao-middleware/internal/dao/services/job_offer"
 	"casper-dao-middleware/internal/dao/services/jobs"
+	"casper-dao-middleware/internal/dao/services/onboarding"
 	"casper-dao-middleware/internal/dao/services/settings"
+	"casper-dao-middleware/internal/dao/services/slashing"
 	"casper-dao-middleware/internal/dao/services/votes"
 
 	"casper-dao-middleware/internal/dao/services/voting"
@@ -21,6 +23,7 @@ import (
 	"github.com/make-software/ces-go-parser"
 
 	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
 	"casper-dao-middleware/internal/dao/events/admin"
 	base_events "casper-dao-middleware/internal/dao/events/base"
 	"casper-dao-middleware/internal/dao/events/kyc_nft"
@@ -33,6 +36,7 @@ import (
 	"casper-dao-middleware/internal/dao/events/slashing_voter"
 	"casper-dao-middleware/internal/dao/events/va_nft"
 	"casper-dao-middleware/internal/dao/events/variable_repository"
+	"casper-dao-middleware/internal/dao/types"
 )
 
 type ProcessContractEvents struct {
@@ -40,13 +44,95 @@ type ProcessContractEvents struct {
 	di.CESEventAware
 	di.DeployProcessedEventAware
 	di.DAOContractsMetadataAware
+
+	// all the events emitted in the processed deploy
+	deployEvents []ces.Event
 }
 
 func NewProcessContractEvents() *ProcessContractEvents {
 	return &ProcessContractEvents{}
 }
 
+func (s *ProcessContractEvents) SetDeployEvents(events []ces.Event) {
+	s.deployEvents = events
+}
+
 func (s *ProcessContractEvents) Execute() error {
+	if err := s.trackContractEvent(); err != nil {
+		return err
+	}
+
+	return s.trackExecutedVotingEffect()
+}
+
+// trackExecutedVotingEffect links Reputation and NFT events to the governance voting passed in the same deploy,
+// as these events are emitted by the action executed on the voting end
+func (s *ProcessContractEvents) trackExecutedVotingEffect() error {
+	cesEvent := s.GetCESEvent()
+	daoContractMetadata := s.GetDAOContractsMetadata()
+
+	switch cesEvent.ContractPackageHash.ToHex() {
+	case daoContractMetadata.ReputationContractPackageHash.ToHex(),
+		daoContractMetadata.VANFTContractPackageHash.ToHex(),
+		daoContractMetadata.KycNFTContractPackageHash.ToHex():
+	default:
+		return nil
+	}
+
+	votingID, err := s.findExecutedVotingID()
+	if err != nil || votingID == nil {
+		return err
+	}
+
+	trackVotingEffect := voting.NewTrackVotingEffect()
+	trackVotingEffect.SetCESEvent(cesEvent)
+	trackVotingEffect.SetEntityManager(s.GetEntityManager())
+	trackVotingEffect.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
+	trackVotingEffect.SetDAOContractsMetadata(daoContractMetadata)
+	trackVotingEffect.SetVotingID(*votingID)
+	if err := trackVotingEffect.Execute(); err != nil {
+		zap.S().With(zap.String("event", cesEvent.Name)).
+			With(zap.Uint32("voting_id", *votingID)).Info("failed to track voting effect")
+		return err
+	}
+
+	return nil
+}
+
+// findExecutedVotingID returns id of the governance voting which formal stage passed in the processed deploy
+func (s *ProcessContractEvents) findExecutedVotingID() (*uint32, error) {
+	daoContractMetadata := s.GetDAOContractsMetadata()
+	governanceContracts := map[string]struct{}{
+		daoContractMetadata.ReputationVoterContractPackageHash.ToHex():   {},
+		daoContractMetadata.SlashingVoterContractPackageHash.ToHex():     {},
+		daoContractMetadata.KycVoterContractPackageHash.ToHex():          {},
+		daoContractMetadata.AdminContractPackageHash.ToHex():             {},
+		daoContractMetadata.OnboardingRequestContractPackageHash.ToHex(): {},
+	}
+
+	for _, event := range s.deployEvents {
+		if event.Name != base_events.VotingEndedEventName {
+			continue
+		}
+
+		if _, ok := governanceContracts[event.ContractPackageHash.ToHex()]; !ok {
+			continue
+		}
+
+		votingEnded, err := base_events.ParseVotingEndedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		if votingEnded.VotingType == types.VotingTypeFormal && votingEnded.VotingResult == entities.VotingResultInFavor {
+			return &votingEnded.VotingID, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *ProcessContractEvents) trackContractEvent() error {
 	cesEvent := s.GetCESEvent()
 	doaContractMetadata := s.GetDAOContractsMetadata()
 
@@ -91,6 +177,7 @@ func (s *ProcessContractEvents) trackKycNFTContract(cesEvent ces.Event) error {
 		trackTransfer := account.NewTrackKycTransfer()
 		trackTransfer.SetCESEvent(cesEvent)
 		trackTransfer.SetEntityManager(s.GetEntityManager())
+		trackTransfer.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
 		if err := trackTransfer.Execute(); err != nil {
 			zap.S().With(zap.String("event", cesEvent.Name)).
 				With(zap.String("contract", daoContractMetadata.SlashingVoterContractHash.String())).Info("failed to track event")
@@ -114,11 +201,22 @@ func (s *ProcessContractEvents) trackVANFTContract(cesEvent ces.Event) error {
 		trackTransfer := account.NewTrackVATransfer()
 		trackTransfer.SetCESEvent(cesEvent)
 		trackTransfer.SetEntityManager(s.GetEntityManager())
+		trackTransfer.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
 		if err := trackTransfer.Execute(); err != nil {
 			zap.S().With(zap.String("event", cesEvent.Name)).
 				With(zap.String("contract", daoContractMetadata.VANFTContractHash.String())).Info("failed to track event")
 			return err
 		}
+
+		trackVAGranted := onboarding.NewTrackVAGranted()
+		trackVAGranted.SetCESEvent(cesEvent)
+		trackVAGranted.SetEntityManager(s.GetEntityManager())
+		trackVAGranted.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
+		if err := trackVAGranted.Execute(); err != nil {
+			zap.S().With(zap.String("event", cesEvent.Name)).
+				With(zap.String("contract", daoContractMetadata.VANFTContractHash.String())).Info("failed to track event")
+			return err
+		}
 	default:
 		return fmt.Errorf("unsupported contract event - %s", cesEvent.Name)
 	}
@@ -213,6 +311,16 @@ func (s *ProcessContractEvents) trackRepoVoterContract(cesEvent ces.Event) error
 				With(zap.String("contract", daoContractMetadata.RepoVoterContractHash.String())).Info("failed to track event")
 			return err
 		}
+
+		trackSettingProposalVotingEnded := settings.NewTrackSettingProposalVotingEnded()
+		trackSettingProposalVotingEnded.SetCESEvent(cesEvent)
+		trackSettingProposalVotingEnded.SetEntityManager(s.GetEntityManager())
+		trackSettingProposalVotingEnded.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
+		if err := trackSettingProposalVotingEnded.Execute(); err != nil {
+			zap.S().With(zap.String("event", cesEvent.Name)).
+				With(zap.String("contract", daoContractMetadata.RepoVoterContractHash.String())).Info("failed to track event")
+			return err
+		}
 	case base_events.VotingCanceledEventName:
 		trackVotingCanceled := voting.NewTrackVotingCanceled()
 		trackVotingCanceled.SetCESEvent(cesEvent)
@@ -225,6 +333,16 @@ func (s *ProcessContractEvents) trackRepoVoterContract(cesEvent ces.Event) error
 				With(zap.String("contract", daoContractMetadata.RepoVoterContractHash.String())).Info("failed to track event")
 			return err
 		}
+
+		trackSettingProposalVotingCanceled := settings.NewTrackSettingProposalVotingCanceled()
+		trackSettingProposalVotingCanceled.SetCESEvent(cesEvent)
+		trackSettingProposalVotingCanceled.SetEntityManager(s.GetEntityManager())
+		trackSettingProposalVotingCanceled.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
+		if err := trackSettingProposalVotingCanceled.Execute(); err != nil {
+			zap.S().With(zap.String("event", cesEvent.Name)).
+				With(zap.String("contract", daoContractMetadata.RepoVoterContractHash.String())).Info("failed to track event")
+			return err
+		}
 	case base_events.BallotCastEventName:
 		trackBallotCast := votes.NewTrackVote()
 		trackBallotCast.SetCESEvent(cesEvent)
@@ -241,6 +359,7 @@ func (s *ProcessContractEvents) trackRepoVoterContract(cesEvent ces.Event) error
 		trackBallotCanceled := votes.NewTrackCanceledVote()
 		trackBallotCanceled.SetCESEvent(cesEvent)
 		trackBallotCanceled.SetEntityManager(s.GetEntityManager())
+		trackBallotCanceled.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
 		if err := trackBallotCanceled.Execute(); err != nil {
 			zap.S().With(zap.String("event", cesEvent.Name)).
 				With(zap.String("contract", daoContractMetadata.RepoVoterContractHash.String())).Info("failed to track event")
@@ -310,6 +429,7 @@ func (s *ProcessContractEvents) trackReputationVoterContract(cesEvent ces.Event)
 		trackBallotCanceled := votes.NewTrackCanceledVote()
 		trackBallotCanceled.SetCESEvent(cesEvent)
 		trackBallotCanceled.SetEntityManager(s.GetEntityManager())
+		trackBallotCanceled.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
 		if err := trackBallotCanceled.Execute(); err != nil {
 			zap.S().With(zap.String("event", cesEvent.Name)).
 				With(zap.String("contract", daoContractMetadata.ReputationVoterContractHash.String())).Info("failed to track event")
@@ -379,6 +499,7 @@ func (s *ProcessContractEvents) trackSimpleVoterContract(cesEvent ces.Event) err
 		trackBallotCanceled := votes.NewTrackCanceledVote()
 		trackBallotCanceled.SetCESEvent(cesEvent)
 		trackBallotCanceled.SetEntityManager(s.GetEntityManager())
+		trackBallotCanceled.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
 		if err := trackBallotCanceled.Execute(); err != nil {
 			zap.S().With(zap.String("event", cesEvent.Name)).
 				With(zap.String("contract", daoContractMetadata.SimpleVoterContractHash.String())).Info("failed to track event")
@@ -420,6 +541,18 @@ func (s *ProcessContractEvents) trackSlashingVoterContract(cesEvent ces.Event) e
 				With(zap.String("contract", daoContractMetadata.SlashingVoterContractHash.String())).Info("failed to track event")
 			return err
 		}
+
+		trackSlashingVotingEnded := slashing.NewTrackSlashingVotingEnded()
+		trackSlashingVotingEnded.SetCESEvent(cesEvent)
+		trackSlashingVotingEnded.SetEntityManager(s.GetEntityManager())
+		trackSlashingVotingEnded.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
+		trackSlashingVotingEnded.SetDAOContractsMetadata(daoContractMetadata)
+		trackSlashingVotingEnded.SetDeployEvents(s.deployEvents)
+		if err := trackSlashingVotingEnded.Execute(); err != nil {
+			zap.S().With(zap.String("event", cesEvent.Name)).
+				With(zap.String("contract", daoContractMetadata.SlashingVoterContractHash.String())).Info("failed to track event")
+			return err
+		}
 	case base_events.VotingCanceledEventName:
 		trackVotingCanceled := voting.NewTrackVotingCanceled()
 		trackVotingCanceled.SetCESEvent(cesEvent)
@@ -432,6 +565,16 @@ func (s *ProcessContractEvents) trackSlashingVoterContract(cesEvent ces.Event) e
 				With(zap.String("contract", daoContractMetadata.SlashingVoterContractHash.String())).Info("failed to track event")
 			return err
 		}
+
+		trackSlashingVotingCanceled := slashing.NewTrackSlashingVotingCanceled()
+		trackSlashingVotingCanceled.SetCESEvent(cesEvent)
+		trackSlashingVotingCanceled.SetEntityManager(s.GetEntityManager())
+		trackSlashingVotingCanceled.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
+		if err := trackSlashingVotingCanceled.Execute(); err != nil {
+			zap.S().With(zap.String("event", cesEvent.Name)).
+				With(zap.String("contract", daoContractMetadata.SlashingVoterContractHash.String())).Info("failed to track event")
+			return err
+		}
 	case base_events.BallotCastEventName:
 		trackBallotCast := votes.NewTrackVote()
 		trackBallotCast.SetCESEvent(cesEvent)
@@ -448,6 +591,7 @@ func (s *ProcessContractEvents) trackSlashingVoterContract(cesEvent ces.Event) e
 		trackBallotCanceled := votes.NewTrackCanceledVote()
 		trackBallotCanceled.SetCESEvent(cesEvent)
 		trackBallotCanceled.SetEntityManager(s.GetEntityManager())
+		trackBallotCanceled.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
 		if err := trackBallotCanceled.Execute(); err != nil {
 			zap.S().With(zap.String("event", cesEvent.Name)).
 				With(zap.String("contract", daoContractMetadata.SlashingVoterContractHash.String())).Info("failed to track event")
@@ -517,6 +661,7 @@ func (s *ProcessContractEvents) trackKycVoterContract(cesEvent ces.Event) error
 		trackBallotCanceled := votes.NewTrackCanceledVote()
 		trackBallotCanceled.SetCESEvent(cesEvent)
 		trackBallotCanceled.SetEntityManager(s.GetEntityManager())
+		trackBallotCanceled.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
 		if err := trackBallotCanceled.Execute(); err != nil {
 			zap.S().With(zap.String("event", cesEvent.Name)).
 				With(zap.String("contract", daoContractMetadata.KycVoterContractHash.String())).Info("failed to track event")
@@ -558,6 +703,16 @@ func (s *ProcessContractEvents) trackOnboardingRequestContract(cesEvent ces.Even
 				With(zap.String("contract", daoContractMetadata.OnboardingRequestContractHash.String())).Info("failed to track event")
 			return err
 		}
+
+		trackOnboardingVotingEnded := onboarding.NewTrackOnboardingVotingEnded()
+		trackOnboardingVotingEnded.SetCESEvent(cesEvent)
+		trackOnboardingVotingEnded.SetEntityManager(s.GetEntityManager())
+		trackOnboardingVotingEnded.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
+		if err := trackOnboardingVotingEnded.Execute(); err != nil {
+			zap.S().With(zap.String("event", cesEvent.Name)).
+				With(zap.String("contract", daoContractMetadata.OnboardingRequestContractHash.String())).Info("failed to track event")
+			return err
+		}
 	case base_events.VotingCanceledEventName:
 		trackVotingCanceled := voting.NewTrackVotingCanceled()
 		trackVotingCanceled.SetCESEvent(cesEvent)
@@ -570,6 +725,16 @@ func (s *ProcessContractEvents) trackOnboardingRequestContract(cesEvent ces.Even
 				With(zap.String("contract", daoContractMetadata.OnboardingRequestContractHash.String())).Info("failed to track event")
 			return err
 		}
+
+		trackOnboardingVotingCanceled := onboarding.NewTrackOnboardingVotingCanceled()
+		trackOnboardingVotingCanceled.SetCESEvent(cesEvent)
+		trackOnboardingVotingCanceled.SetEntityManager(s.GetEntityManager())
+		trackOnboardingVotingCanceled.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
+		if err := trackOnboardingVotingCanceled.Execute(); err != nil {
+			zap.S().With(zap.String("event", cesEvent.Name)).
+				With(zap.String("contract", daoContractMetadata.OnboardingRequestContractHash.String())).Info("failed to track event")
+			return err
+		}
 	case base_events.BallotCastEventName:
 		trackBallotCast := votes.NewTrackVote()
 		trackBallotCast.SetCESEvent(cesEvent)
@@ -586,6 +751,7 @@ func (s *ProcessContractEvents) trackOnboardingRequestContract(cesEvent ces.Even
 		trackBallotCanceled := votes.NewTrackCanceledVote()
 		trackBallotCanceled.SetCESEvent(cesEvent)
 		trackBallotCanceled.SetEntityManager(s.GetEntityManager())
+		trackBallotCanceled.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
 		if err := trackBallotCanceled.Execute(); err != nil {
 			zap.S().With(zap.String("event", cesEvent.Name)).
 				With(zap.String("contract", daoContractMetadata.OnboardingRequestContractHash.String())).Info("failed to track event")
@@ -656,6 +822,7 @@ func (s *ProcessContractEvents) trackAdminContract(cesEvent ces.Event) error {
 		trackBallotCanceled := votes.NewTrackCanceledVote()
 		trackBallotCanceled.SetCESEvent(cesEvent)
 		trackBallotCanceled.SetEntityManager(s.GetEntityManager())
+		trackBallotCanceled.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
 		if err := trackBallotCanceled.Execute(); err != nil {
 			zap.S().With(zap.String("event", cesEvent.Name)).
 				With(zap.String("contract", daoContractMetadata.AdminContractHash.String())).Info("failed to track event")
@@ -679,6 +846,7 @@ func (s *ProcessContractEvents) trackVariableRepositoryContract(cesEvent ces.Eve
 		trackValueUpdated := settings.NewTrackUpdatedSetting()
 		trackValueUpdated.SetCESEvent(cesEvent)
 		trackValueUpdated.SetEntityManager(s.GetEntityManager())
+		trackValueUpdated.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
 		if err := trackValueUpdated.Execute(); err != nil {
 			zap.S().With(zap.String("event", cesEvent.Name)).
 				With(zap.String("contract", daoContractMetadata.VariableRepositoryContractHash.String())).Info("failed to track event")
@@ -795,6 +963,7 @@ func (s *ProcessContractEvents) trackBidEscrowRepositoryContract(cesEvent ces.Ev
 		trackBallotCanceled := votes.NewTrackCanceledVote()
 		trackBallotCanceled.SetCESEvent(cesEvent)
 		trackBallotCanceled.SetEntityManager(s.GetEntityManager())
+		trackBallotCanceled.SetDeployProcessedEvent(s.GetDeployProcessedEvent())
 		if err := trackBallotCanceled.Execute(); err != nil {
 			zap.S().With(zap.String("event", cesEvent.Name)).
 				With(zap.String("contract", daoContractMetadata.AdminContractHash.String())).Info("failed to track event")
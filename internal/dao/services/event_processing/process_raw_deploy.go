@@ -1,6 +1,7 @@
 package event_processing
 
 import (
+	"github.com/make-software/ces-go-parser"
 	"go.uber.org/zap"
 
 	"casper-dao-middleware/internal/dao/di"
@@ -33,10 +34,16 @@ func (c *ProcessRawDeploy) Execute() error {
 		}
 	}
 
+	deployEvents := make([]ces.Event, 0, len(results))
+	for _, result := range results {
+		deployEvents = append(deployEvents, result.Event)
+	}
+
 	processContractEvents := NewProcessContractEvents()
 	processContractEvents.SetDAOContractsMetadata(daoContractsMetadata)
 	processContractEvents.SetDeployProcessedEvent(c.GetDeployProcessedEvent())
 	processContractEvents.SetEntityManager(c.GetEntityManager())
+	processContractEvents.SetDeployEvents(deployEvents)
 
 	for _, result := range results {
 		processContractEvents.SetCESEvent(result.Event)
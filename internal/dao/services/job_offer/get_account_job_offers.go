@@ -0,0 +1,39 @@
+package job_offer
+
+import (
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetAccountJobOffers struct {
+	di.PaginationParamsAware
+	di.EntityManagerAware
+
+	address casper.Hash
+}
+
+func NewGetAccountJobOffers() *GetAccountJobOffers {
+	return &GetAccountJobOffers{}
+}
+
+func (c *GetAccountJobOffers) SetAddress(address casper.Hash) {
+	c.address = address
+}
+
+func (c *GetAccountJobOffers) Execute() (*pagination.Result, error) {
+	filters := map[string]interface{}{}
+
+	count, err := c.GetEntityManager().JobOfferRepository().CountByAccount(c.address, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	offers, err := c.GetEntityManager().JobOfferRepository().FindByAccount(c.GetPaginationParams(), c.address, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, offers), nil
+}
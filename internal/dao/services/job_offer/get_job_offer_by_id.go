@@ -0,0 +1,24 @@
+package job_offer
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+type GetJobOfferByID struct {
+	di.EntityManagerAware
+
+	jobOfferID uint32
+}
+
+func NewGetJobOfferByID() *GetJobOfferByID {
+	return &GetJobOfferByID{}
+}
+
+func (c *GetJobOfferByID) SetJobOfferID(jobOfferID uint32) {
+	c.jobOfferID = jobOfferID
+}
+
+func (c *GetJobOfferByID) Execute() (*entities.JobOffer, error) {
+	return c.GetEntityManager().JobOfferRepository().GetByID(c.jobOfferID)
+}
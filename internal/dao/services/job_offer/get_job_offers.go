@@ -1,22 +1,112 @@
 package job_offer
 
 import (
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+
 	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
 	"casper-dao-middleware/pkg/pagination"
 )
 
 type GetJobOffers struct {
 	di.PaginationParamsAware
 	di.EntityManagerAware
+
+	jobPoster             *casper.Hash
+	auctionTypeIDs        []entities.AuctionTypeID
+	maxBudgetFrom         *uint64
+	maxBudgetTo           *uint64
+	expectedTimeFrameFrom *uint64
+	expectedTimeFrameTo   *uint64
+	from                  *time.Time
+	to                    *time.Time
+	state                 *entities.JobOfferState
+	jobStatusIDs          []entities.JobStatusID
 }
 
 func NewGetJobOffers() *GetJobOffers {
 	return &GetJobOffers{}
 }
 
+func (c *GetJobOffers) SetJobPoster(jobPoster *casper.Hash) {
+	c.jobPoster = jobPoster
+}
+
+func (c *GetJobOffers) SetAuctionTypeIDs(auctionTypeIDs []entities.AuctionTypeID) {
+	c.auctionTypeIDs = auctionTypeIDs
+}
+
+func (c *GetJobOffers) SetMaxBudgetRange(from, to *uint64) {
+	c.maxBudgetFrom = from
+	c.maxBudgetTo = to
+}
+
+func (c *GetJobOffers) SetExpectedTimeFrameRange(from, to *uint64) {
+	c.expectedTimeFrameFrom = from
+	c.expectedTimeFrameTo = to
+}
+
+func (c *GetJobOffers) SetFrom(from *time.Time) {
+	c.from = from
+}
+
+func (c *GetJobOffers) SetTo(to *time.Time) {
+	c.to = to
+}
+
+func (c *GetJobOffers) SetState(state *entities.JobOfferState) {
+	c.state = state
+}
+
+func (c *GetJobOffers) SetJobStatusIDs(jobStatusIDs []entities.JobStatusID) {
+	c.jobStatusIDs = jobStatusIDs
+}
+
 func (c *GetJobOffers) Execute() (*pagination.Result, error) {
 	filters := map[string]interface{}{}
 
+	if c.jobPoster != nil {
+		filters["job_poster"] = *c.jobPoster
+	}
+
+	if len(c.auctionTypeIDs) != 0 {
+		filters["auction_type_id"] = c.auctionTypeIDs
+	}
+
+	if c.maxBudgetFrom != nil {
+		filters["max_budget_from"] = *c.maxBudgetFrom
+	}
+
+	if c.maxBudgetTo != nil {
+		filters["max_budget_to"] = *c.maxBudgetTo
+	}
+
+	if c.expectedTimeFrameFrom != nil {
+		filters["expected_time_frame_from"] = *c.expectedTimeFrameFrom
+	}
+
+	if c.expectedTimeFrameTo != nil {
+		filters["expected_time_frame_to"] = *c.expectedTimeFrameTo
+	}
+
+	if c.from != nil {
+		filters["from"] = *c.from
+	}
+
+	if c.to != nil {
+		filters["to"] = *c.to
+	}
+
+	if c.state != nil {
+		filters["state"] = *c.state
+	}
+
+	if len(c.jobStatusIDs) != 0 {
+		filters["job_status_id"] = c.jobStatusIDs
+	}
+
 	count, err := c.GetEntityManager().JobOfferRepository().Count(filters)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetAccountJobs struct {
+	di.PaginationParamsAware
+	di.EntityManagerAware
+
+	address      casper.Hash
+	role         *entities.JobRole
+	jobStatusIDs []entities.JobStatusID
+}
+
+func NewGetAccountJobs() *GetAccountJobs {
+	return &GetAccountJobs{}
+}
+
+func (c *GetAccountJobs) SetAddress(address casper.Hash) {
+	c.address = address
+}
+
+func (c *GetAccountJobs) SetRole(role *entities.JobRole) {
+	c.role = role
+}
+
+func (c *GetAccountJobs) SetJobStatusIDs(jobStatusIDs []entities.JobStatusID) {
+	c.jobStatusIDs = jobStatusIDs
+}
+
+func (c *GetAccountJobs) Execute() (*pagination.Result, error) {
+	filters := map[string]interface{}{}
+
+	if len(c.jobStatusIDs) != 0 {
+		filters["job_status_id"] = c.jobStatusIDs
+	}
+
+	count, err := c.GetEntityManager().JobRepository().CountByAccount(c.address, c.role, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := c.GetEntityManager().JobRepository().FindByAccount(c.GetPaginationParams(), c.address, c.role, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, jobs), nil
+}
@@ -0,0 +1,69 @@
+package jobs
+
+import (
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetAccountPayments struct {
+	di.EntityManagerAware
+	di.PaginationParamsAware
+
+	address         casper.Hash
+	csprFlowTypeIDs []entities.CSPRFlowTypeID
+	from            *time.Time
+	to              *time.Time
+}
+
+func NewGetAccountPayments() *GetAccountPayments {
+	return &GetAccountPayments{}
+}
+
+func (c *GetAccountPayments) SetAddress(address casper.Hash) {
+	c.address = address
+}
+
+func (c *GetAccountPayments) SetCSPRFlowTypeIDs(csprFlowTypeIDs []entities.CSPRFlowTypeID) {
+	c.csprFlowTypeIDs = csprFlowTypeIDs
+}
+
+func (c *GetAccountPayments) SetFrom(from *time.Time) {
+	c.from = from
+}
+
+func (c *GetAccountPayments) SetTo(to *time.Time) {
+	c.to = to
+}
+
+func (c *GetAccountPayments) Execute() (*pagination.Result, error) {
+	filters := map[string]interface{}{}
+
+	if len(c.csprFlowTypeIDs) != 0 {
+		filters["cspr_flow_type_id"] = c.csprFlowTypeIDs
+	}
+
+	if c.from != nil {
+		filters["from"] = *c.from
+	}
+
+	if c.to != nil {
+		filters["to"] = *c.to
+	}
+
+	count, err := c.GetEntityManager().CSPRFlowRepository().CountByAccount(c.address, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	flows, err := c.GetEntityManager().CSPRFlowRepository().FindByAccount(c.GetPaginationParams(), c.address, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, flows), nil
+}
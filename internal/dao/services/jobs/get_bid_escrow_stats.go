@@ -0,0 +1,84 @@
+package jobs
+
+import (
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+// GetBidEscrowStats calculates account performance as a worker and as a job poster
+type GetBidEscrowStats struct {
+	di.EntityManagerAware
+
+	address casper.Hash
+}
+
+func NewGetBidEscrowStats() *GetBidEscrowStats {
+	return &GetBidEscrowStats{}
+}
+
+func (c *GetBidEscrowStats) SetAddress(address casper.Hash) {
+	c.address = address
+}
+
+func (c *GetBidEscrowStats) Execute() (*entities.BidEscrowStats, error) {
+	stats := entities.BidEscrowStats{
+		Address: c.address,
+	}
+
+	workerRole := entities.JobRoleWorker
+	jobsByStatus := map[entities.JobStatusID]*uint64{
+		entities.JobStatusIDDone:      &stats.JobsDone,
+		entities.JobStatusIDRejected:  &stats.JobsRejected,
+		entities.JobStatusIDCancelled: &stats.JobsCancelled,
+	}
+
+	for jobStatusID, counter := range jobsByStatus {
+		count, err := c.GetEntityManager().JobRepository().CountByAccount(c.address, &workerRole, map[string]interface{}{
+			"job_status_id": jobStatusID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		*counter = count
+	}
+
+	timeFrames, err := c.GetEntityManager().JobRepository().CalculateWorkerTimeFrames(c.address)
+	if err != nil {
+		return nil, err
+	}
+	stats.AvgProposedTimeFrame = timeFrames.AvgProposedTimeFrame
+	stats.AvgActualTimeFrame = timeFrames.AvgActualTimeFrame
+
+	stats.TotalProposedPayment, err = c.GetEntityManager().BidRepository().SumProposedPaymentByAccount(c.address)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.BidsSubmitted, err = c.GetEntityManager().BidRepository().CountByAccount(c.address, map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	stats.BidsWon, err = c.GetEntityManager().BidRepository().CountByAccount(c.address, map[string]interface{}{
+		"picked_by_job_poster": true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats.JobOffersPosted, err = c.GetEntityManager().JobOfferRepository().CountByAccount(c.address, map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	stats.JobOffersCompleted, err = c.GetEntityManager().JobOfferRepository().CountByAccount(c.address, map[string]interface{}{
+		"job_status_id": []entities.JobStatusID{entities.JobStatusIDDone},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
@@ -0,0 +1,44 @@
+package jobs
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetJobHistory struct {
+	di.EntityManagerAware
+	di.PaginationParamsAware
+
+	jobID uint32
+}
+
+func NewGetJobHistory() *GetJobHistory {
+	return &GetJobHistory{}
+}
+
+func (c *GetJobHistory) SetJobID(jobID uint32) {
+	c.jobID = jobID
+}
+
+func (c *GetJobHistory) Execute() (*pagination.Result, error) {
+	// make sure job exists to respond with not found instead of empty history
+	if _, err := c.GetEntityManager().JobRepository().GetByID(c.jobID); err != nil {
+		return nil, err
+	}
+
+	filters := map[string]interface{}{
+		"job_id": c.jobID,
+	}
+
+	count, err := c.GetEntityManager().JobStatusChangeRepository().Count(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := c.GetEntityManager().JobStatusChangeRepository().Find(c.GetPaginationParams(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, changes), nil
+}
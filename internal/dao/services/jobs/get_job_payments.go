@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetJobPayments struct {
+	di.EntityManagerAware
+	di.PaginationParamsAware
+
+	jobID           uint32
+	csprFlowTypeIDs []entities.CSPRFlowTypeID
+}
+
+func NewGetJobPayments() *GetJobPayments {
+	return &GetJobPayments{}
+}
+
+func (c *GetJobPayments) SetJobID(jobID uint32) {
+	c.jobID = jobID
+}
+
+func (c *GetJobPayments) SetCSPRFlowTypeIDs(csprFlowTypeIDs []entities.CSPRFlowTypeID) {
+	c.csprFlowTypeIDs = csprFlowTypeIDs
+}
+
+func (c *GetJobPayments) Execute() (*pagination.Result, error) {
+	job, err := c.GetEntityManager().JobRepository().GetByID(c.jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	// flows are tracked by Bid, so the worker stake deposited before the Job creation is included as well
+	filters := map[string]interface{}{
+		"bid_id": job.BidID,
+	}
+
+	if len(c.csprFlowTypeIDs) != 0 {
+		filters["cspr_flow_type_id"] = c.csprFlowTypeIDs
+	}
+
+	count, err := c.GetEntityManager().CSPRFlowRepository().Count(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	flows, err := c.GetEntityManager().CSPRFlowRepository().Find(c.GetPaginationParams(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, flows), nil
+}
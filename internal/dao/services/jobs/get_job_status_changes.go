@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetJobStatusChanges struct {
+	di.PaginationParamsAware
+	di.EntityManagerAware
+
+	jobIDs []uint32
+}
+
+func NewGetJobStatusChanges() *GetJobStatusChanges {
+	return &GetJobStatusChanges{}
+}
+
+func (c *GetJobStatusChanges) SetJobIDs(jobIDs []uint32) {
+	c.jobIDs = jobIDs
+}
+
+func (c *GetJobStatusChanges) Execute() (*pagination.Result, error) {
+	filters := map[string]interface{}{}
+
+	if len(c.jobIDs) != 0 {
+		filters["job_id"] = c.jobIDs
+	}
+
+	count, err := c.GetEntityManager().JobStatusChangeRepository().Count(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := c.GetEntityManager().JobStatusChangeRepository().Find(c.GetPaginationParams(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, changes), nil
+}
@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetJobs struct {
+	di.PaginationParamsAware
+	di.EntityManagerAware
+
+	bidIDs []uint32
+}
+
+func NewGetJobs() *GetJobs {
+	return &GetJobs{}
+}
+
+func (c *GetJobs) SetBidIDs(bidIDs []uint32) {
+	c.bidIDs = bidIDs
+}
+
+func (c *GetJobs) Execute() (*pagination.Result, error) {
+	filters := map[string]interface{}{}
+
+	if len(c.bidIDs) != 0 {
+		filters["bid_id"] = c.bidIDs
+	}
+
+	count, err := c.GetEntityManager().JobRepository().Count(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := c.GetEntityManager().JobRepository().Find(c.GetPaginationParams(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, jobs), nil
+}
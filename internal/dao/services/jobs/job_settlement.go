@@ -0,0 +1,118 @@
+package jobs
+
+import (
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+	"github.com/make-software/casper-go-sdk/sse"
+
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+// jobSettlement describes CSPR released by the BidEscrow contract when the Job is finished
+type jobSettlement struct {
+	job        *entities.Job
+	jobOfferID uint32
+	// flow types of the transfers sent to the worker and to the accounts other than worker and job poster
+	workerFlowType entities.CSPRFlowTypeID
+	otherFlowType  entities.CSPRFlowTypeID
+	// flow announced in the event, used when the deploy contains no transfers to accounts
+	eventFlowType  entities.CSPRFlowTypeID
+	eventRecipient casper.Hash
+	eventAmount    uint64
+	// CSPR stake of the picked bid, the transfer of exactly this amount to the worker is the stake return
+	workerStake *uint64
+	// whether the worker stake neither returned nor transferred to anyone in the deploy is kept by the contract
+	isStakeForfeited bool
+}
+
+func (s jobSettlement) buildFlows(deployProcessed sse.DeployProcessedPayload) ([]entities.CSPRFlow, error) {
+	transfers, err := parseAccountTransfers(deployProcessed.ExecutionResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildFlowsFromTransfers(transfers, deployProcessed.DeployHash, deployProcessed.Timestamp), nil
+}
+
+func (s jobSettlement) buildFlowsFromTransfers(transfers []*casper.WriteTransfer, deployHash casper.Hash, timestamp time.Time) []entities.CSPRFlow {
+	flows := make([]entities.CSPRFlow, 0, len(transfers)+1)
+	newFlow := func(flowType entities.CSPRFlowTypeID, sender, recipient *casper.Hash, amount uint64) entities.CSPRFlow {
+		return entities.NewCSPRFlow(
+			s.jobOfferID,
+			s.job.BidID,
+			&s.job.JobID,
+			flowType,
+			sender,
+			recipient,
+			amount,
+			deployHash,
+			timestamp,
+		)
+	}
+
+	if len(transfers) == 0 {
+		recipient := s.eventRecipient
+		flows = append(flows, newFlow(s.eventFlowType, nil, &recipient, s.eventAmount))
+	}
+
+	isStakeReturned := false
+	hasForfeitedTransfers := false
+	for _, transfer := range transfers {
+		recipient := transfer.To.Hash
+
+		flowType := s.otherFlowType
+		switch recipient {
+		case s.job.Worker:
+			flowType = s.workerFlowType
+			if s.workerStake != nil && !isStakeReturned && transfer.Amount == *s.workerStake {
+				isStakeReturned = true
+				flowType = entities.CSPRFlowTypeIDStakeReturn
+			}
+		case s.job.JobPoster:
+			flowType = entities.CSPRFlowTypeIDRefund
+		}
+
+		var sender *casper.Hash
+		if flowType == entities.CSPRFlowTypeIDForfeitedStake {
+			hasForfeitedTransfers = true
+			sender = &s.job.Worker
+		}
+
+		flows = append(flows, newFlow(flowType, sender, &recipient, transfer.Amount))
+	}
+
+	if s.isStakeForfeited && s.workerStake != nil && !isStakeReturned && !hasForfeitedTransfers {
+		flows = append(flows, newFlow(entities.CSPRFlowTypeIDForfeitedStake, &s.job.Worker, nil, *s.workerStake))
+	}
+
+	return flows
+}
+
+// parseAccountTransfers returns CSPR transfers to accounts performed during the deploy execution
+func parseAccountTransfers(executionResult casper.ExecutionResult) ([]*casper.WriteTransfer, error) {
+	if executionResult.Success == nil {
+		return nil, nil
+	}
+
+	transfers := make([]*casper.WriteTransfer, 0)
+	for _, transform := range executionResult.Success.Effect.Transforms {
+		if !transform.Transform.IsWriteTransfer() {
+			continue
+		}
+
+		transfer, err := transform.Transform.ParseAsWriteTransfer()
+		if err != nil {
+			return nil, err
+		}
+
+		// purse to purse transfers are internal contract movements
+		if transfer.To == nil {
+			continue
+		}
+
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers, nil
+}
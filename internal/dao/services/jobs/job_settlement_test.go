@@ -0,0 +1,188 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+	"github.com/stretchr/testify/assert"
+
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+func TestJobSettlementBuildFlows(t *testing.T) {
+	worker := casper.Hash{1}
+	jobPoster := casper.Hash{2}
+	voter := casper.Hash{3}
+	stake := uint64(100)
+
+	job := &entities.Job{
+		JobID:     1,
+		BidID:     2,
+		Worker:    worker,
+		JobPoster: jobPoster,
+	}
+
+	transfer := func(recipient casper.Hash, amount uint64) *casper.WriteTransfer {
+		return &casper.WriteTransfer{
+			To:     &casper.AccountHash{Hash: recipient},
+			Amount: amount,
+		}
+	}
+
+	type expectedFlow struct {
+		flowType  entities.CSPRFlowTypeID
+		sender    *casper.Hash
+		recipient *casper.Hash
+		amount    uint64
+	}
+
+	tests := []struct {
+		name       string
+		settlement jobSettlement
+		transfers  []*casper.WriteTransfer
+		expected   []expectedFlow
+	}{
+		{
+			name: "done: payout, fee and returned stake",
+			settlement: jobSettlement{
+				workerFlowType: entities.CSPRFlowTypeIDWorkerPayout,
+				otherFlowType:  entities.CSPRFlowTypeIDDAOFee,
+				workerStake:    &stake,
+			},
+			transfers: []*casper.WriteTransfer{
+				transfer(worker, 900),
+				transfer(voter, 50),
+				transfer(worker, stake),
+			},
+			expected: []expectedFlow{
+				{entities.CSPRFlowTypeIDWorkerPayout, nil, &worker, 900},
+				{entities.CSPRFlowTypeIDDAOFee, nil, &voter, 50},
+				{entities.CSPRFlowTypeIDStakeReturn, nil, &worker, stake},
+			},
+		},
+		{
+			name: "done: payout equal to the stake is returned once",
+			settlement: jobSettlement{
+				workerFlowType: entities.CSPRFlowTypeIDWorkerPayout,
+				otherFlowType:  entities.CSPRFlowTypeIDDAOFee,
+				workerStake:    &stake,
+			},
+			transfers: []*casper.WriteTransfer{
+				transfer(worker, stake),
+				transfer(worker, stake),
+			},
+			expected: []expectedFlow{
+				{entities.CSPRFlowTypeIDStakeReturn, nil, &worker, stake},
+				{entities.CSPRFlowTypeIDWorkerPayout, nil, &worker, stake},
+			},
+		},
+		{
+			name: "done: no transfers in the deploy",
+			settlement: jobSettlement{
+				workerFlowType: entities.CSPRFlowTypeIDWorkerPayout,
+				otherFlowType:  entities.CSPRFlowTypeIDDAOFee,
+				eventFlowType:  entities.CSPRFlowTypeIDWorkerPayout,
+				eventRecipient: worker,
+				eventAmount:    900,
+				workerStake:    &stake,
+			},
+			expected: []expectedFlow{
+				{entities.CSPRFlowTypeIDWorkerPayout, nil, &worker, 900},
+			},
+		},
+		{
+			name: "cancel: refund and forfeited stake",
+			settlement: jobSettlement{
+				workerFlowType:   entities.CSPRFlowTypeIDRefund,
+				otherFlowType:    entities.CSPRFlowTypeIDForfeitedStake,
+				workerStake:      &stake,
+				isStakeForfeited: true,
+			},
+			transfers: []*casper.WriteTransfer{
+				transfer(jobPoster, 1000),
+			},
+			expected: []expectedFlow{
+				{entities.CSPRFlowTypeIDRefund, nil, &jobPoster, 1000},
+				{entities.CSPRFlowTypeIDForfeitedStake, &worker, nil, stake},
+			},
+		},
+		{
+			name: "cancel: returned stake is not forfeited",
+			settlement: jobSettlement{
+				workerFlowType:   entities.CSPRFlowTypeIDRefund,
+				otherFlowType:    entities.CSPRFlowTypeIDForfeitedStake,
+				workerStake:      &stake,
+				isStakeForfeited: true,
+			},
+			transfers: []*casper.WriteTransfer{
+				transfer(jobPoster, 1000),
+				transfer(worker, stake),
+			},
+			expected: []expectedFlow{
+				{entities.CSPRFlowTypeIDRefund, nil, &jobPoster, 1000},
+				{entities.CSPRFlowTypeIDStakeReturn, nil, &worker, stake},
+			},
+		},
+		{
+			name: "cancel: no transfers in the deploy",
+			settlement: jobSettlement{
+				workerFlowType:   entities.CSPRFlowTypeIDRefund,
+				otherFlowType:    entities.CSPRFlowTypeIDForfeitedStake,
+				eventFlowType:    entities.CSPRFlowTypeIDRefund,
+				eventRecipient:   jobPoster,
+				eventAmount:      1000,
+				workerStake:      &stake,
+				isStakeForfeited: true,
+			},
+			expected: []expectedFlow{
+				{entities.CSPRFlowTypeIDRefund, nil, &jobPoster, 1000},
+				{entities.CSPRFlowTypeIDForfeitedStake, &worker, nil, stake},
+			},
+		},
+		{
+			name: "slash: stake transferred to the voters",
+			settlement: jobSettlement{
+				workerFlowType: entities.CSPRFlowTypeIDRefund,
+				otherFlowType:  entities.CSPRFlowTypeIDForfeitedStake,
+				workerStake:    &stake,
+			},
+			transfers: []*casper.WriteTransfer{
+				transfer(jobPoster, 1000),
+				transfer(voter, stake),
+			},
+			expected: []expectedFlow{
+				{entities.CSPRFlowTypeIDRefund, nil, &jobPoster, 1000},
+				{entities.CSPRFlowTypeIDForfeitedStake, &worker, &voter, stake},
+			},
+		},
+	}
+
+	deployHash := casper.Hash{4}
+	timestamp := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			settlement := test.settlement
+			settlement.job = job
+			settlement.jobOfferID = 3
+
+			flows := settlement.buildFlowsFromTransfers(test.transfers, deployHash, timestamp)
+
+			assert.Equal(t, len(test.expected), len(flows))
+			for i, expected := range test.expected {
+				if i >= len(flows) {
+					break
+				}
+				assert.Equal(t, expected.flowType, flows[i].CSPRFlowTypeID)
+				assert.Equal(t, expected.sender, flows[i].Sender)
+				assert.Equal(t, expected.recipient, flows[i].Recipient)
+				assert.Equal(t, expected.amount, flows[i].Amount)
+				assert.Equal(t, uint32(3), flows[i].JobOfferID)
+				assert.Equal(t, job.BidID, flows[i].BidID)
+				assert.Equal(t, deployHash, flows[i].DeployHash)
+				assert.Equal(t, timestamp, flows[i].Timestamp)
+			}
+		})
+	}
+}
@@ -30,5 +30,47 @@ func (s *TrackJobCancelled) Execute() error {
 	job.Caller = &jobCancelled.Caller
 	job.JobStatusID = entities.JobStatusIDCancelled
 
-	return s.GetEntityManager().JobRepository().Update(job)
+	if err := s.GetEntityManager().JobRepository().Update(job); err != nil {
+		return err
+	}
+
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+	statusChange := entities.NewJobStatusChange(
+		job.JobID,
+		job.BidID,
+		entities.JobStatusIDCancelled,
+		&jobCancelled.Caller,
+		nil,
+		deployProcessed.DeployHash,
+		deployProcessed.Timestamp,
+	)
+
+	if err := s.GetEntityManager().JobStatusChangeRepository().Save(&statusChange); err != nil {
+		return err
+	}
+
+	bid, err := s.GetEntityManager().BidRepository().GetByID(job.BidID)
+	if err != nil {
+		return err
+	}
+
+	settlement := jobSettlement{
+		job:            job,
+		jobOfferID:     bid.JobOfferID,
+		workerFlowType: entities.CSPRFlowTypeIDRefund,
+		otherFlowType:  entities.CSPRFlowTypeIDForfeitedStake,
+		eventFlowType:  entities.CSPRFlowTypeIDRefund,
+		eventRecipient: job.JobPoster,
+		eventAmount:    jobCancelled.CSPRAmount.Value().Uint64(),
+		workerStake:    bid.CSPRStake,
+		// the worker missed the deadline, so the CSPR stake is not returned
+		isStakeForfeited: true,
+	}
+
+	flows, err := settlement.buildFlows(deployProcessed)
+	if err != nil {
+		return err
+	}
+
+	return s.GetEntityManager().CSPRFlowRepository().SaveBatch(flows)
 }
@@ -41,5 +41,42 @@ func (s *TrackJobCreated) Execute() error {
 		time.Now().UTC(),
 	)
 
-	return s.GetEntityManager().JobRepository().Save(&job)
+	if err := s.GetEntityManager().JobRepository().Save(&job); err != nil {
+		return err
+	}
+
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+	statusChange := entities.NewJobStatusChange(
+		jobCreated.JobID,
+		jobCreated.BidID,
+		entities.JobStatusIDCreated,
+		nil,
+		nil,
+		deployProcessed.DeployHash,
+		deployProcessed.Timestamp,
+	)
+
+	if err := s.GetEntityManager().JobStatusChangeRepository().Save(&statusChange); err != nil {
+		return err
+	}
+
+	bid, err := s.GetEntityManager().BidRepository().GetByID(jobCreated.BidID)
+	if err != nil {
+		return err
+	}
+
+	// the job poster deposits the payment to the BidEscrow contract on picking the Bid
+	deposit := entities.NewCSPRFlow(
+		bid.JobOfferID,
+		jobCreated.BidID,
+		&jobCreated.JobID,
+		entities.CSPRFlowTypeIDPaymentDeposit,
+		&jobCreated.JobPoster,
+		nil,
+		jobCreated.Payment.Value().Uint64(),
+		deployProcessed.DeployHash,
+		deployProcessed.Timestamp,
+	)
+
+	return s.GetEntityManager().CSPRFlowRepository().SaveBatch([]entities.CSPRFlow{deposit})
 }
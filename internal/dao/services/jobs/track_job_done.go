@@ -30,5 +30,45 @@ func (s *TrackJobDone) Execute() error {
 	job.Caller = &jobDone.Caller
 	job.JobStatusID = entities.JobStatusIDDone
 
-	return s.GetEntityManager().JobRepository().Update(job)
+	if err := s.GetEntityManager().JobRepository().Update(job); err != nil {
+		return err
+	}
+
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+	statusChange := entities.NewJobStatusChange(
+		job.JobID,
+		job.BidID,
+		entities.JobStatusIDDone,
+		&jobDone.Caller,
+		nil,
+		deployProcessed.DeployHash,
+		deployProcessed.Timestamp,
+	)
+
+	if err := s.GetEntityManager().JobStatusChangeRepository().Save(&statusChange); err != nil {
+		return err
+	}
+
+	bid, err := s.GetEntityManager().BidRepository().GetByID(job.BidID)
+	if err != nil {
+		return err
+	}
+
+	settlement := jobSettlement{
+		job:            job,
+		jobOfferID:     bid.JobOfferID,
+		workerFlowType: entities.CSPRFlowTypeIDWorkerPayout,
+		otherFlowType:  entities.CSPRFlowTypeIDDAOFee,
+		eventFlowType:  entities.CSPRFlowTypeIDWorkerPayout,
+		eventRecipient: job.Worker,
+		eventAmount:    jobDone.CSPRAmount.Value().Uint64(),
+		workerStake:    bid.CSPRStake,
+	}
+
+	flows, err := settlement.buildFlows(deployProcessed)
+	if err != nil {
+		return err
+	}
+
+	return s.GetEntityManager().CSPRFlowRepository().SaveBatch(flows)
 }
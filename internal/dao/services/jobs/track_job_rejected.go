@@ -30,5 +30,45 @@ func (s *TrackJobRejected) Execute() error {
 	job.Caller = &jobRejected.Caller
 	job.JobStatusID = entities.JobStatusIDRejected
 
-	return s.GetEntityManager().JobRepository().Update(job)
+	if err := s.GetEntityManager().JobRepository().Update(job); err != nil {
+		return err
+	}
+
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+	statusChange := entities.NewJobStatusChange(
+		job.JobID,
+		job.BidID,
+		entities.JobStatusIDRejected,
+		&jobRejected.Caller,
+		nil,
+		deployProcessed.DeployHash,
+		deployProcessed.Timestamp,
+	)
+
+	if err := s.GetEntityManager().JobStatusChangeRepository().Save(&statusChange); err != nil {
+		return err
+	}
+
+	bid, err := s.GetEntityManager().BidRepository().GetByID(job.BidID)
+	if err != nil {
+		return err
+	}
+
+	settlement := jobSettlement{
+		job:            job,
+		jobOfferID:     bid.JobOfferID,
+		workerFlowType: entities.CSPRFlowTypeIDRefund,
+		otherFlowType:  entities.CSPRFlowTypeIDForfeitedStake,
+		eventFlowType:  entities.CSPRFlowTypeIDRefund,
+		eventRecipient: job.JobPoster,
+		eventAmount:    jobRejected.CSPRAmount.Value().Uint64(),
+		workerStake:    bid.CSPRStake,
+	}
+
+	flows, err := settlement.buildFlows(deployProcessed)
+	if err != nil {
+		return err
+	}
+
+	return s.GetEntityManager().CSPRFlowRepository().SaveBatch(flows)
 }
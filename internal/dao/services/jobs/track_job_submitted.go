@@ -30,5 +30,20 @@ func (s *TrackJobSubmitted) Execute() error {
 	job.Result = &jobSubmitted.Result
 	job.JobStatusID = entities.JobStatusIDSubmitted
 
-	return s.GetEntityManager().JobRepository().Update(job)
+	if err := s.GetEntityManager().JobRepository().Update(job); err != nil {
+		return err
+	}
+
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+	statusChange := entities.NewJobStatusChange(
+		job.JobID,
+		job.BidID,
+		entities.JobStatusIDSubmitted,
+		&jobSubmitted.Worker,
+		&jobSubmitted.Result,
+		deployProcessed.DeployHash,
+		deployProcessed.Timestamp,
+	)
+
+	return s.GetEntityManager().JobStatusChangeRepository().Save(&statusChange)
 }
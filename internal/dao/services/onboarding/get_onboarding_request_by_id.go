@@ -0,0 +1,24 @@
+package onboarding
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+type GetOnboardingRequestByID struct {
+	di.EntityManagerAware
+
+	votingID uint32
+}
+
+func NewGetOnboardingRequestByID() *GetOnboardingRequestByID {
+	return &GetOnboardingRequestByID{}
+}
+
+func (c *GetOnboardingRequestByID) SetVotingID(votingID uint32) {
+	c.votingID = votingID
+}
+
+func (c *GetOnboardingRequestByID) Execute() (*entities.OnboardingRequest, error) {
+	return c.GetEntityManager().OnboardingRequestRepository().GetByVotingID(c.votingID)
+}
@@ -0,0 +1,53 @@
+package onboarding
+
+import (
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetOnboardingRequests struct {
+	di.EntityManagerAware
+	di.PaginationParamsAware
+
+	requester                  *casper.Hash
+	onboardingRequestStatusIDs []entities.OnboardingRequestStatusID
+}
+
+func NewGetOnboardingRequests() *GetOnboardingRequests {
+	return &GetOnboardingRequests{}
+}
+
+func (c *GetOnboardingRequests) SetRequester(requester *casper.Hash) {
+	c.requester = requester
+}
+
+func (c *GetOnboardingRequests) SetOnboardingRequestStatusIDs(statusIDs []entities.OnboardingRequestStatusID) {
+	c.onboardingRequestStatusIDs = statusIDs
+}
+
+func (c *GetOnboardingRequests) Execute() (*pagination.Result, error) {
+	filters := map[string]interface{}{}
+
+	if c.requester != nil {
+		filters["requester"] = *c.requester
+	}
+
+	if len(c.onboardingRequestStatusIDs) != 0 {
+		filters["onboarding_request_status_id"] = c.onboardingRequestStatusIDs
+	}
+
+	count, err := c.GetEntityManager().OnboardingRequestRepository().Count(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := c.GetEntityManager().OnboardingRequestRepository().Find(c.GetPaginationParams(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, requests), nil
+}
@@ -0,0 +1,38 @@
+package onboarding
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/events/base"
+)
+
+type TrackOnboardingVotingCanceled struct {
+	di.EntityManagerAware
+	di.CESEventAware
+	di.DeployProcessedEventAware
+}
+
+func NewTrackOnboardingVotingCanceled() *TrackOnboardingVotingCanceled {
+	return &TrackOnboardingVotingCanceled{}
+}
+
+func (s *TrackOnboardingVotingCanceled) Execute() error {
+	votingCanceled, err := base.ParseVotingCanceledEvent(s.GetCESEvent())
+	if err != nil {
+		return err
+	}
+
+	request, err := s.GetEntityManager().OnboardingRequestRepository().GetByVotingID(votingCanceled.VotingID)
+	if err != nil {
+		return err
+	}
+
+	depositStatusID := entities.DepositStatusIDRefunded
+	resolvedAt := s.GetDeployProcessedEvent().DeployProcessed.Timestamp
+
+	request.OnboardingRequestStatusID = entities.OnboardingRequestStatusIDCanceled
+	request.DepositStatusID = &depositStatusID
+	request.ResolvedAt = &resolvedAt
+
+	return s.GetEntityManager().OnboardingRequestRepository().Update(request)
+}
@@ -0,0 +1,62 @@
+package onboarding
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/events/base"
+	"casper-dao-middleware/internal/dao/types"
+)
+
+type TrackOnboardingVotingEnded struct {
+	di.EntityManagerAware
+	di.CESEventAware
+	di.DeployProcessedEventAware
+}
+
+func NewTrackOnboardingVotingEnded() *TrackOnboardingVotingEnded {
+	return &TrackOnboardingVotingEnded{}
+}
+
+func (s *TrackOnboardingVotingEnded) Execute() error {
+	votingEnded, err := base.ParseVotingEndedEvent(s.GetCESEvent())
+	if err != nil {
+		return err
+	}
+
+	// informal voting is followed by the formal one unless the quorum is not reached
+	if votingEnded.VotingType == types.VotingTypeInformal && votingEnded.VotingResult != entities.VotingResultQuorumNotReached {
+		return nil
+	}
+
+	request, err := s.GetEntityManager().OnboardingRequestRepository().GetByVotingID(votingEnded.VotingID)
+	if err != nil {
+		return err
+	}
+
+	// the deposit is returned to the requester unless the VAs voted against the request
+	depositStatusID := entities.DepositStatusIDRefunded
+
+	switch votingEnded.VotingResult {
+	case entities.VotingResultInFavor:
+		request.OnboardingRequestStatusID = entities.OnboardingRequestStatusIDAccepted
+	case entities.VotingResultAgainst:
+		request.OnboardingRequestStatusID = entities.OnboardingRequestStatusIDRejected
+		depositStatusID = entities.DepositStatusIDForfeited
+	case entities.VotingResultCanceled:
+		request.OnboardingRequestStatusID = entities.OnboardingRequestStatusIDCanceled
+	default:
+		request.OnboardingRequestStatusID = entities.OnboardingRequestStatusIDRejected
+	}
+
+	// VA NFT linked while the request was pending was not granted by the onboarding
+	if request.OnboardingRequestStatusID != entities.OnboardingRequestStatusIDAccepted {
+		request.VATokenID = nil
+		request.VAGrantedDeployHash = nil
+	}
+
+	resolvedAt := s.GetDeployProcessedEvent().DeployProcessed.Timestamp
+	request.DepositStatusID = &depositStatusID
+	request.ResolvedAt = &resolvedAt
+
+	return s.GetEntityManager().OnboardingRequestRepository().Update(request)
+}
@@ -0,0 +1,46 @@
+package onboarding
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/events/va_nft"
+	"casper-dao-middleware/pkg/errors"
+)
+
+type TrackVAGranted struct {
+	di.EntityManagerAware
+	di.CESEventAware
+	di.DeployProcessedEventAware
+}
+
+func NewTrackVAGranted() *TrackVAGranted {
+	return &TrackVAGranted{}
+}
+
+func (s *TrackVAGranted) Execute() error {
+	event, err := va_nft.ParseTransferEvent(s.GetCESEvent())
+	if err != nil {
+		return err
+	}
+
+	// only minted tokens are granted as the result of onboarding
+	if event.From != nil || event.To == nil {
+		return nil
+	}
+
+	request, err := s.GetEntityManager().OnboardingRequestRepository().GetAwaitingVAGrant(*event.To.ToHash())
+	if err != nil {
+		// VA could be granted without onboarding request, e.g. by the admin
+		if _, ok := err.(*errors.NotFoundError); ok {
+			return nil
+		}
+		return err
+	}
+
+	tokenID := event.TokenID.Value().Uint64()
+	deployHash := s.GetDeployProcessedEvent().DeployProcessed.DeployHash
+
+	request.VATokenID = &tokenID
+	request.VAGrantedDeployHash = &deployHash
+
+	return s.GetEntityManager().OnboardingRequestRepository().Update(request)
+}
@@ -0,0 +1,84 @@
+package reputation
+
+import (
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+// GetAccountReputation rebuilds liquid and staked reputation of the account from reputation_changes
+// as of the provided moment (or deploy), current balance is returned if no moment provided
+type GetAccountReputation struct {
+	di.EntityManagerAware
+	di.DAOContractsMetadataAware
+
+	address        casper.Hash
+	asOfTime       *time.Time
+	asOfDeployHash *casper.Hash
+}
+
+func NewGetAccountReputation() *GetAccountReputation {
+	return &GetAccountReputation{}
+}
+
+func (s *GetAccountReputation) SetAddress(address casper.Hash) {
+	s.address = address
+}
+
+func (s *GetAccountReputation) SetAsOfTime(asOf *time.Time) {
+	s.asOfTime = asOf
+}
+
+func (s *GetAccountReputation) SetAsOfDeployHash(deployHash *casper.Hash) {
+	s.asOfDeployHash = deployHash
+}
+
+func (s *GetAccountReputation) Execute() (*entities.AccountReputation, error) {
+	asOf := time.Now().UTC()
+	if s.asOfTime != nil {
+		asOf = *s.asOfTime
+	}
+
+	var (
+		balances []entities.ReputationBalance
+		err      error
+	)
+
+	if s.asOfDeployHash != nil {
+		asOf, err = s.GetEntityManager().ReputationChangeRepository().GetTimestampByDeployHash(*s.asOfDeployHash)
+		if err != nil {
+			return nil, err
+		}
+
+		balances, err = s.GetEntityManager().ReputationChangeRepository().CalculateBalancesForAddressAtDeploy(s.address, *s.asOfDeployHash, asOf)
+	} else {
+		balances, err = s.GetEntityManager().ReputationChangeRepository().CalculateBalancesForAddressAt(s.address, asOf)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	reputationContractPackageHash := s.GetDAOContractsMetadata().ReputationContractPackageHash
+
+	accountReputation := entities.AccountReputation{
+		Address:                 s.address,
+		AsOf:                    asOf,
+		StakedByContractPackage: make([]entities.ReputationBalance, 0),
+	}
+
+	for _, balance := range balances {
+		if balance.ContractPackageHash == reputationContractPackageHash {
+			accountReputation.LiquidReputation += balance.Amount
+		} else {
+			accountReputation.StakedReputation += balance.Amount
+			accountReputation.StakedByContractPackage = append(accountReputation.StakedByContractPackage, balance)
+		}
+	}
+
+	accountReputation.TotalReputation = accountReputation.LiquidReputation + accountReputation.StakedReputation
+
+	return &accountReputation, nil
+}
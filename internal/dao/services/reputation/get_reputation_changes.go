@@ -0,0 +1,91 @@
+package reputation
+
+import (
+	"time"
+
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetReputationChanges struct {
+	di.EntityManagerAware
+	di.PaginationParamsAware
+
+	address               *casper.Hash
+	reasons               []entities.ReputationChangeReason
+	contractPackageHashes []casper.Hash
+	votingIDs             []uint32
+	from                  *time.Time
+	to                    *time.Time
+}
+
+func NewGetReputationChanges() *GetReputationChanges {
+	return &GetReputationChanges{}
+}
+
+func (s *GetReputationChanges) SetAddress(address *casper.Hash) {
+	s.address = address
+}
+
+func (s *GetReputationChanges) SetReasons(reasons []entities.ReputationChangeReason) {
+	s.reasons = reasons
+}
+
+func (s *GetReputationChanges) SetContractPackageHashes(hashes []casper.Hash) {
+	s.contractPackageHashes = hashes
+}
+
+func (s *GetReputationChanges) SetVotingIDs(ids []uint32) {
+	s.votingIDs = ids
+}
+
+func (s *GetReputationChanges) SetFrom(from *time.Time) {
+	s.from = from
+}
+
+func (s *GetReputationChanges) SetTo(to *time.Time) {
+	s.to = to
+}
+
+func (s *GetReputationChanges) Execute() (*pagination.Result, error) {
+	filters := map[string]interface{}{}
+
+	if s.address != nil {
+		filters["address"] = *s.address
+	}
+
+	if len(s.reasons) != 0 {
+		filters["reason"] = s.reasons
+	}
+
+	if len(s.contractPackageHashes) != 0 {
+		filters["contract_package_hash"] = s.contractPackageHashes
+	}
+
+	if len(s.votingIDs) != 0 {
+		filters["voting_id"] = s.votingIDs
+	}
+
+	if s.from != nil {
+		filters["from"] = *s.from
+	}
+
+	if s.to != nil {
+		filters["to"] = *s.to
+	}
+
+	count, err := s.GetEntityManager().ReputationChangeRepository().Count(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.GetEntityManager().ReputationChangeRepository().Find(s.GetPaginationParams(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, s.GetPaginationParams().PageSize, changes), nil
+}
@@ -0,0 +1,61 @@
+package reputation
+
+import (
+	"time"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetReputationLeaderboard struct {
+	di.EntityManagerAware
+	di.PaginationParamsAware
+
+	isVA *bool
+	from *time.Time
+	to   *time.Time
+}
+
+func NewGetReputationLeaderboard() *GetReputationLeaderboard {
+	return &GetReputationLeaderboard{}
+}
+
+func (s *GetReputationLeaderboard) SetIsVA(isVA *bool) {
+	s.isVA = isVA
+}
+
+func (s *GetReputationLeaderboard) SetFrom(from *time.Time) {
+	s.from = from
+}
+
+func (s *GetReputationLeaderboard) SetTo(to *time.Time) {
+	s.to = to
+}
+
+func (s *GetReputationLeaderboard) Execute() (*pagination.Result, error) {
+	filters := map[string]interface{}{}
+
+	if s.isVA != nil {
+		filters["is_va"] = *s.isVA
+	}
+
+	if s.from != nil {
+		filters["from"] = *s.from
+	}
+
+	if s.to != nil {
+		filters["to"] = *s.to
+	}
+
+	count, err := s.GetEntityManager().ReputationBalanceRepository().CountAggregated(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	balances, err := s.GetEntityManager().ReputationBalanceRepository().FindAggregated(s.GetPaginationParams(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, s.GetPaginationParams().PageSize, balances), nil
+}
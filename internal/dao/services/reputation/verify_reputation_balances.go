@@ -0,0 +1,52 @@
+package reputation
+
+import (
+	"go.uber.org/zap"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+// VerifyReputationBalances compares reputation_balances with the full reputation_changes ledger,
+// optionally rebuilding the balances if any mismatch is found
+type VerifyReputationBalances struct {
+	di.EntityManagerAware
+
+	rebuild bool
+}
+
+func NewVerifyReputationBalances() *VerifyReputationBalances {
+	return &VerifyReputationBalances{}
+}
+
+func (s *VerifyReputationBalances) SetRebuild(rebuild bool) {
+	s.rebuild = rebuild
+}
+
+func (s *VerifyReputationBalances) Execute() ([]entities.ReputationBalanceMismatch, error) {
+	mismatches, err := s.GetEntityManager().ReputationBalanceRepository().FindMismatches()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mismatch := range mismatches {
+		zap.S().With(
+			zap.String("address", mismatch.Address.ToHex()),
+			zap.String("contract_package_hash", mismatch.ContractPackageHash.ToHex()),
+			zap.Int64("balance_amount", mismatch.BalanceAmount),
+			zap.Int64("ledger_amount", mismatch.LedgerAmount),
+		).Warn("Reputation balance mismatch")
+	}
+
+	if len(mismatches) == 0 || !s.rebuild {
+		return mismatches, nil
+	}
+
+	if err := s.GetEntityManager().ReputationBalanceRepository().Rebuild(); err != nil {
+		return nil, err
+	}
+
+	zap.S().With(zap.Int("mismatches", len(mismatches))).Info("Reputation balances rebuilt from reputation changes")
+
+	return mismatches, nil
+}
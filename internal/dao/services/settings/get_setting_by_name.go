@@ -0,0 +1,48 @@
+package settings
+
+import (
+	"time"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+type GetSettingByName struct {
+	di.EntityManagerAware
+
+	name        string
+	effectiveAt *time.Time
+}
+
+func NewGetSettingByName() *GetSettingByName {
+	return &GetSettingByName{}
+}
+
+func (c *GetSettingByName) SetName(name string) {
+	c.name = name
+}
+
+// SetEffectiveAt requests the value of the setting which was current at the provided time
+func (c *GetSettingByName) SetEffectiveAt(effectiveAt *time.Time) {
+	c.effectiveAt = effectiveAt
+}
+
+func (c *GetSettingByName) Execute() (*entities.Setting, error) {
+	if c.effectiveAt != nil {
+		change, err := c.GetEntityManager().SettingChangeRepository().GetEffectiveAt(c.name, *c.effectiveAt)
+		if err != nil {
+			return nil, err
+		}
+
+		setting := entities.NewSetting(change.Name, change.Value, change.ValueTypeID, nil, nil)
+		return &setting, nil
+	}
+
+	setting, err := c.GetEntityManager().SettingRepository().GetByName(c.name)
+	if err != nil {
+		return nil, err
+	}
+
+	setting.ApplyPendingValue(time.Now().UTC())
+	return setting, nil
+}
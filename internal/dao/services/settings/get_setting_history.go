@@ -0,0 +1,39 @@
+package settings
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetSettingHistory struct {
+	di.EntityManagerAware
+	di.PaginationParamsAware
+
+	name string
+}
+
+func NewGetSettingHistory() *GetSettingHistory {
+	return &GetSettingHistory{}
+}
+
+func (c *GetSettingHistory) SetName(name string) {
+	c.name = name
+}
+
+func (c *GetSettingHistory) Execute() (*pagination.Result, error) {
+	filters := map[string]interface{}{
+		"name": c.name,
+	}
+
+	count, err := c.GetEntityManager().SettingChangeRepository().Count(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := c.GetEntityManager().SettingChangeRepository().Find(c.GetPaginationParams(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, changes), nil
+}
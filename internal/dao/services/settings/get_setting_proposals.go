@@ -0,0 +1,81 @@
+package settings
+
+import (
+	"time"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/errors"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetSettingProposals struct {
+	di.EntityManagerAware
+	di.PaginationParamsAware
+
+	name                     *string
+	settingProposalStatusIDs []entities.SettingProposalStatusID
+}
+
+func NewGetSettingProposals() *GetSettingProposals {
+	return &GetSettingProposals{}
+}
+
+func (c *GetSettingProposals) SetName(name *string) {
+	c.name = name
+}
+
+func (c *GetSettingProposals) SetSettingProposalStatusIDs(statusIDs []entities.SettingProposalStatusID) {
+	c.settingProposalStatusIDs = statusIDs
+}
+
+func (c *GetSettingProposals) Execute() (*pagination.Result, error) {
+	filters := map[string]interface{}{}
+
+	if c.name != nil {
+		filters["name"] = *c.name
+	}
+
+	if len(c.settingProposalStatusIDs) != 0 {
+		filters["setting_proposal_status_id"] = c.settingProposalStatusIDs
+	}
+
+	count, err := c.GetEntityManager().SettingProposalRepository().Count(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals, err := c.GetEntityManager().SettingProposalRepository().Find(c.GetPaginationParams(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	currentValues := make(map[string]*entities.Setting)
+	currentTime := time.Now().UTC()
+
+	for _, proposal := range proposals {
+		setting, ok := currentValues[proposal.Name]
+		if !ok {
+			setting, err = c.GetEntityManager().SettingRepository().GetByName(proposal.Name)
+			if err != nil {
+				if _, ok := err.(*errors.NotFoundError); !ok {
+					return nil, err
+				}
+			} else {
+				setting.ApplyPendingValue(currentTime)
+			}
+			currentValues[proposal.Name] = setting
+		}
+
+		if setting == nil {
+			continue
+		}
+
+		proposal.CurrentValue = &setting.Value
+		if proposal.ValueTypeID == nil {
+			proposal.ValueTypeID = setting.ValueTypeID
+		}
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, proposals), nil
+}
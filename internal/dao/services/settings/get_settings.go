@@ -31,14 +31,7 @@ func (c *GetPaginatedSettings) Execute() (*pagination.Result, error) {
 
 	currentTime := time.Now().UTC()
 	for i := range settings {
-		activationTime := settings[i].ActivationTime
-		if activationTime == nil || settings[i].NextValue == nil {
-			continue
-		}
-
-		if activationTime.Before(currentTime) {
-			settings[i].Value = *settings[i].NextValue
-		}
+		settings[i].ApplyPendingValue(currentTime)
 	}
 
 	return pagination.NewResult(count, c.GetPaginationParams().PageSize, settings), nil
@@ -46,6 +46,9 @@ func (c *SyncInitialDAOSettings) Execute() error {
 		return err
 	}
 
+	deployHash := deployResult.Deploy.Hash
+	timestamp := time.Time(deployResult.Deploy.Header.Timestamp).UTC()
+
 	for _, result := range results {
 		if result.Error != nil {
 			zap.S().With(zap.Error(err)).Error("Failed to parse ces events")
@@ -56,7 +59,7 @@ func (c *SyncInitialDAOSettings) Execute() error {
 			continue
 		}
 
-		if err := c.trackValueUpdatedEvent(result.Event); err != nil {
+		if err := c.trackValueUpdatedEvent(result.Event, deployHash, timestamp); err != nil {
 			zap.S().With(zap.Error(err)).Error("Failed to track ValueUpdated event")
 		}
 	}
@@ -64,20 +67,26 @@ func (c *SyncInitialDAOSettings) Execute() error {
 	return nil
 }
 
-func (c *SyncInitialDAOSettings) trackValueUpdatedEvent(event ces.Event) error {
+func (c *SyncInitialDAOSettings) trackValueUpdatedEvent(event ces.Event, deployHash casper.Hash, timestamp time.Time) error {
 	valueUpdated, err := variable_repository.ParseValueUpdatedEvent(event)
 	if err != nil {
 		return err
 	}
 
-	var activationTime *time.Time
+	activationTime := parseActivationTime(valueUpdated)
+	valueTypeID := entities.NewSettingValueTypeID(valueUpdated.Value)
+
+	change := entities.NewSettingChange(valueUpdated.Key, valueUpdated.Value.String(), valueTypeID, activationTime, deployHash, timestamp)
+	if err := c.GetEntityManager().SettingChangeRepository().Save(&change); err != nil {
+		return err
+	}
 
-	if valueUpdated.ActivationTime != nil {
-		newTime := time.Unix(int64(*valueUpdated.ActivationTime), 0)
-		activationTime = &newTime
+	setting := entities.NewSetting(valueUpdated.Key, valueUpdated.Value.String(), valueTypeID, nil, nil)
+	if activationTime != nil {
+		setting.NextValue = &change.Value
+		setting.ActivationTime = activationTime
 	}
 
-	setting := entities.NewSetting(valueUpdated.Key, valueUpdated.Value.String(), nil, activationTime)
 	if err := c.GetEntityManager().SettingRepository().Save(setting); err != nil {
 		return err
 	}
@@ -0,0 +1,223 @@
+package settings
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/make-software/casper-go-sdk/types/clvalue"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/blake2b"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/types"
+	"casper-dao-middleware/pkg/errors"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+const defaultStorageDictionaryName = "storage"
+
+// SyncSettingsFromChain reads the records of the Variable Repository storage dictionary at the state root hash,
+// upserts the current and future values of the settings and reports the settings which differ from the chain state.
+// The dictionary keys can not be listed through RPC, so the keys known by the catalog and the stored settings are queried
+type SyncSettingsFromChain struct {
+	di.EntityManagerAware
+	di.CasperClientAware
+	di.DAOContractsMetadataAware
+
+	stateRootHash         *string
+	storageDictionaryName string
+}
+
+func NewSyncSettingsFromChain() *SyncSettingsFromChain {
+	return &SyncSettingsFromChain{
+		storageDictionaryName: defaultStorageDictionaryName,
+	}
+}
+
+// SetStateRootHash sets the state to read the settings at, the latest state is used by default
+func (s *SyncSettingsFromChain) SetStateRootHash(stateRootHash *string) {
+	s.stateRootHash = stateRootHash
+}
+
+func (s *SyncSettingsFromChain) SetStorageDictionaryName(name string) {
+	s.storageDictionaryName = name
+}
+
+func (s *SyncSettingsFromChain) Execute() ([]entities.SettingMismatch, error) {
+	ctx := context.Background()
+
+	stateRootHash := s.stateRootHash
+	if stateRootHash == nil {
+		stateRootHashRes, err := s.GetCasperClient().GetStateRootHashLatest(ctx)
+		if err != nil {
+			return nil, err
+		}
+		latestStateRootHash := stateRootHashRes.StateRootHash.String()
+		stateRootHash = &latestStateRootHash
+	}
+
+	contractHash := "hash-" + s.GetDAOContractsMetadata().VariableRepositoryContractHash.ToHex()
+	contractRes, err := s.GetCasperClient().QueryGlobalStateByStateHash(ctx, stateRootHash, contractHash, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	if contractRes.StoredValue.Contract == nil {
+		return nil, fmt.Errorf("expected Contract StoredValue of Variable Repository %s", contractHash)
+	}
+
+	storageKey, err := contractRes.StoredValue.Contract.NamedKeys.Find(s.storageDictionaryName)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := s.collectSettingNames()
+	if err != nil {
+		return nil, err
+	}
+
+	mismatches := make([]entities.SettingMismatch, 0)
+	for _, name := range names {
+		dictionaryRes, err := s.GetCasperClient().GetDictionaryItem(ctx, stateRootHash, storageKey.String(), toDictionaryKey(name))
+		if err != nil {
+			zap.S().With(zap.Error(err)).With(zap.String("setting", name)).Warn("Failed to query setting from Variable Repository storage")
+			continue
+		}
+
+		if dictionaryRes.StoredValue.CLValue == nil {
+			zap.S().With(zap.String("setting", name)).Warn("Expected CLValue of the Variable Repository record")
+			continue
+		}
+
+		recordBytes, err := dictionaryRes.StoredValue.CLValue.Bytes()
+		if err != nil {
+			return nil, err
+		}
+
+		record, err := types.NewRecordFromBytes(recordBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		mismatch, err := s.syncSetting(name, record)
+		if err != nil {
+			return nil, err
+		}
+
+		if mismatch != nil {
+			zap.S().With(zap.String("setting", name)).
+				With(zap.Any("stored_value", mismatch.StoredValue)).
+				With(zap.String("chain_value", mismatch.ChainValue)).Warn("Setting mismatch with Variable Repository state")
+			mismatches = append(mismatches, *mismatch)
+		}
+	}
+
+	return mismatches, nil
+}
+
+// syncSetting upserts the setting from the record and returns the mismatch if the stored setting differs from it
+func (s *SyncSettingsFromChain) syncSetting(name string, record types.Record) (*entities.SettingMismatch, error) {
+	chainSetting := entities.NewSetting(name, record.Value.String(), entities.NewSettingValueTypeID(record.Value), nil, nil)
+	if record.FutureValue != nil {
+		nextValue := record.FutureValue.Value.String()
+		activationTime := time.UnixMilli(int64(record.FutureValue.ActivationTime)).UTC()
+
+		chainSetting.NextValue = &nextValue
+		chainSetting.ActivationTime = &activationTime
+	}
+
+	var storedValue, storedNextValue *string
+
+	storedSetting, err := s.GetEntityManager().SettingRepository().GetByName(name)
+	if err != nil {
+		if _, ok := err.(*errors.NotFoundError); !ok {
+			return nil, err
+		}
+	} else {
+		storedValue = &storedSetting.Value
+		storedNextValue = storedSetting.NextValue
+	}
+
+	if err := s.GetEntityManager().SettingRepository().Upsert(chainSetting); err != nil {
+		return nil, err
+	}
+
+	if storedValue != nil && *storedValue == chainSetting.Value && equalOptionalStrings(storedNextValue, chainSetting.NextValue) {
+		return nil, nil
+	}
+
+	// the corrected values are recorded to the history, so it stays consistent with the settings
+	syncedAt := time.Now().UTC()
+	changes := make([]entities.SettingChange, 0, 2)
+	if storedValue == nil || *storedValue != chainSetting.Value {
+		changes = append(changes, entities.NewSyncedSettingChange(name, chainSetting.Value, chainSetting.ValueTypeID, nil, syncedAt))
+	}
+	if chainSetting.NextValue != nil && !equalOptionalStrings(storedNextValue, chainSetting.NextValue) {
+		changes = append(changes, entities.NewSyncedSettingChange(name, *chainSetting.NextValue, chainSetting.ValueTypeID, chainSetting.ActivationTime, syncedAt))
+	}
+
+	for i := range changes {
+		if err := s.GetEntityManager().SettingChangeRepository().Save(&changes[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return &entities.SettingMismatch{
+		Name:                name,
+		StoredValue:         storedValue,
+		StoredNextValue:     storedNextValue,
+		ChainValue:          chainSetting.Value,
+		ChainNextValue:      chainSetting.NextValue,
+		ChainActivationTime: chainSetting.ActivationTime,
+	}, nil
+}
+
+func (s *SyncSettingsFromChain) collectSettingNames() ([]string, error) {
+	namesMap := make(map[string]struct{})
+	for _, name := range entities.GetSettingNames() {
+		namesMap[name] = struct{}{}
+	}
+
+	filters := map[string]interface{}{}
+	count, err := s.GetEntityManager().SettingRepository().Count(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &pagination.Params{
+		Page:     1,
+		PageSize: count,
+	}
+	storedSettings, err := s.GetEntityManager().SettingRepository().Find(params, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, setting := range storedSettings {
+		namesMap[setting.Name] = struct{}{}
+	}
+
+	names := make([]string, 0, len(namesMap))
+	for name := range namesMap {
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// toDictionaryKey builds the dictionary item key of the Mapping as the hex of blake2b hash of the serialized key
+func toDictionaryKey(name string) string {
+	hash := blake2b.Sum256(clvalue.NewCLString(name).Bytes())
+	return hex.EncodeToString(hash[:])
+}
+
+func equalOptionalStrings(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
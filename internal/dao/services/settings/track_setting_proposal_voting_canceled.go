@@ -0,0 +1,35 @@
+package settings
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/events/base"
+)
+
+type TrackSettingProposalVotingCanceled struct {
+	di.EntityManagerAware
+	di.CESEventAware
+	di.DeployProcessedEventAware
+}
+
+func NewTrackSettingProposalVotingCanceled() *TrackSettingProposalVotingCanceled {
+	return &TrackSettingProposalVotingCanceled{}
+}
+
+func (s *TrackSettingProposalVotingCanceled) Execute() error {
+	votingCanceled, err := base.ParseVotingCanceledEvent(s.GetCESEvent())
+	if err != nil {
+		return err
+	}
+
+	proposal, err := s.GetEntityManager().SettingProposalRepository().GetByVotingID(votingCanceled.VotingID)
+	if err != nil {
+		return err
+	}
+
+	resolvedAt := s.GetDeployProcessedEvent().DeployProcessed.Timestamp
+	proposal.SettingProposalStatusID = entities.SettingProposalStatusIDCanceled
+	proposal.ResolvedAt = &resolvedAt
+
+	return s.GetEntityManager().SettingProposalRepository().Update(proposal)
+}
@@ -0,0 +1,49 @@
+package settings
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/events/base"
+	"casper-dao-middleware/internal/dao/types"
+)
+
+type TrackSettingProposalVotingEnded struct {
+	di.EntityManagerAware
+	di.CESEventAware
+	di.DeployProcessedEventAware
+}
+
+func NewTrackSettingProposalVotingEnded() *TrackSettingProposalVotingEnded {
+	return &TrackSettingProposalVotingEnded{}
+}
+
+func (s *TrackSettingProposalVotingEnded) Execute() error {
+	votingEnded, err := base.ParseVotingEndedEvent(s.GetCESEvent())
+	if err != nil {
+		return err
+	}
+
+	// informal voting is followed by the formal one unless the quorum is not reached
+	if votingEnded.VotingType == types.VotingTypeInformal && votingEnded.VotingResult != entities.VotingResultQuorumNotReached {
+		return nil
+	}
+
+	proposal, err := s.GetEntityManager().SettingProposalRepository().GetByVotingID(votingEnded.VotingID)
+	if err != nil {
+		return err
+	}
+
+	switch votingEnded.VotingResult {
+	case entities.VotingResultInFavor:
+		proposal.SettingProposalStatusID = entities.SettingProposalStatusIDAccepted
+	case entities.VotingResultCanceled:
+		proposal.SettingProposalStatusID = entities.SettingProposalStatusIDCanceled
+	default:
+		proposal.SettingProposalStatusID = entities.SettingProposalStatusIDRejected
+	}
+
+	resolvedAt := s.GetDeployProcessedEvent().DeployProcessed.Timestamp
+	proposal.ResolvedAt = &resolvedAt
+
+	return s.GetEntityManager().SettingProposalRepository().Update(proposal)
+}
@@ -6,11 +6,13 @@ import (
 	"casper-dao-middleware/internal/dao/di"
 	"casper-dao-middleware/internal/dao/entities"
 	"casper-dao-middleware/internal/dao/events/variable_repository"
+	"casper-dao-middleware/pkg/errors"
 )
 
 type TrackUpdatedSetting struct {
 	di.EntityManagerAware
 	di.CESEventAware
+	di.DeployProcessedEventAware
 }
 
 func NewTrackUpdatedSetting() *TrackUpdatedSetting {
@@ -23,13 +25,36 @@ func (s *TrackUpdatedSetting) Execute() error {
 		return err
 	}
 
-	var activationTime *time.Time
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+	activationTime := parseActivationTime(valueUpdated)
+	valueTypeID := entities.NewSettingValueTypeID(valueUpdated.Value)
 
-	if valueUpdated.ActivationTime != nil {
-		newTime := time.Unix(int64(*valueUpdated.ActivationTime), 0)
-		activationTime = &newTime
+	change := entities.NewSettingChange(valueUpdated.Key, valueUpdated.Value.String(), valueTypeID, activationTime, deployProcessed.DeployHash, deployProcessed.Timestamp)
+	if err := s.GetEntityManager().SettingChangeRepository().Save(&change); err != nil {
+		return err
+	}
+
+	setting, err := s.GetEntityManager().SettingRepository().GetByName(valueUpdated.Key)
+	if err != nil {
+		if _, ok := err.(*errors.NotFoundError); !ok {
+			return err
+		}
+		// the previous value is unknown, so the scheduled one is the only known value of the setting
+		newSetting := entities.NewSetting(change.Name, change.Value, valueTypeID, nil, nil)
+		setting = &newSetting
+	}
+
+	setting.ApplyUpdate(change.Value, valueTypeID, activationTime, deployProcessed.Timestamp)
+
+	return s.GetEntityManager().SettingRepository().Upsert(*setting)
+}
+
+// parseActivationTime converts the Variable Repository activation time in block time milliseconds to time
+func parseActivationTime(valueUpdated variable_repository.ValueUpdatedEvent) *time.Time {
+	if valueUpdated.ActivationTime == nil {
+		return nil
 	}
 
-	setting := entities.NewSetting(valueUpdated.Key, valueUpdated.Value.String(), nil, activationTime)
-	return s.GetEntityManager().SettingRepository().Upsert(setting)
+	activationTime := time.UnixMilli(int64(*valueUpdated.ActivationTime)).UTC()
+	return &activationTime
 }
@@ -0,0 +1,53 @@
+package slashing
+
+import (
+	"github.com/make-software/casper-go-sdk/casper"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetSlashings struct {
+	di.EntityManagerAware
+	di.PaginationParamsAware
+
+	addressToSlash    *casper.Hash
+	slashingStatusIDs []entities.SlashingStatusID
+}
+
+func NewGetSlashings() *GetSlashings {
+	return &GetSlashings{}
+}
+
+func (c *GetSlashings) SetAddressToSlash(address *casper.Hash) {
+	c.addressToSlash = address
+}
+
+func (c *GetSlashings) SetSlashingStatusIDs(statusIDs []entities.SlashingStatusID) {
+	c.slashingStatusIDs = statusIDs
+}
+
+func (c *GetSlashings) Execute() (*pagination.Result, error) {
+	filters := map[string]interface{}{}
+
+	if c.addressToSlash != nil {
+		filters["address_to_slash"] = *c.addressToSlash
+	}
+
+	if len(c.slashingStatusIDs) != 0 {
+		filters["slashing_status_id"] = c.slashingStatusIDs
+	}
+
+	count, err := c.GetEntityManager().SlashingRepository().Count(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	slashings, err := c.GetEntityManager().SlashingRepository().Find(c.GetPaginationParams(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, slashings), nil
+}
@@ -0,0 +1,37 @@
+package slashing
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/events/base"
+)
+
+type TrackSlashingVotingCanceled struct {
+	di.EntityManagerAware
+	di.CESEventAware
+	di.DeployProcessedEventAware
+}
+
+func NewTrackSlashingVotingCanceled() *TrackSlashingVotingCanceled {
+	return &TrackSlashingVotingCanceled{}
+}
+
+func (s *TrackSlashingVotingCanceled) Execute() error {
+	votingCanceled, err := base.ParseVotingCanceledEvent(s.GetCESEvent())
+	if err != nil {
+		return err
+	}
+
+	slashing, err := s.GetEntityManager().SlashingRepository().GetByVotingID(votingCanceled.VotingID)
+	if err != nil {
+		return err
+	}
+
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+
+	slashing.SlashingStatusID = entities.SlashingStatusIDCanceled
+	slashing.ResolvedDeployHash = &deployProcessed.DeployHash
+	slashing.ResolvedAt = &deployProcessed.Timestamp
+
+	return s.GetEntityManager().SlashingRepository().Update(slashing)
+}
@@ -0,0 +1,97 @@
+package slashing
+
+import (
+	"github.com/make-software/ces-go-parser"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/events/base"
+	"casper-dao-middleware/internal/dao/events/reputation"
+	"casper-dao-middleware/internal/dao/types"
+)
+
+type TrackSlashingVotingEnded struct {
+	di.EntityManagerAware
+	di.CESEventAware
+	di.DeployProcessedEventAware
+	di.DAOContractsMetadataAware
+
+	deployEvents []ces.Event
+}
+
+func NewTrackSlashingVotingEnded() *TrackSlashingVotingEnded {
+	return &TrackSlashingVotingEnded{}
+}
+
+// SetDeployEvents sets all the events emitted in the deploy, slashing outcome is emitted by the other contracts
+func (s *TrackSlashingVotingEnded) SetDeployEvents(events []ces.Event) {
+	s.deployEvents = events
+}
+
+func (s *TrackSlashingVotingEnded) Execute() error {
+	votingEnded, err := base.ParseVotingEndedEvent(s.GetCESEvent())
+	if err != nil {
+		return err
+	}
+
+	// informal voting is followed by the formal one unless the quorum is not reached
+	if votingEnded.VotingType == types.VotingTypeInformal && votingEnded.VotingResult != entities.VotingResultQuorumNotReached {
+		return nil
+	}
+
+	slashing, err := s.GetEntityManager().SlashingRepository().GetByVotingID(votingEnded.VotingID)
+	if err != nil {
+		return err
+	}
+
+	switch votingEnded.VotingResult {
+	case entities.VotingResultInFavor:
+		slashing.SlashingStatusID = entities.SlashingStatusIDSlashed
+		if err := s.calculateSlashedAmounts(slashing); err != nil {
+			return err
+		}
+	case entities.VotingResultCanceled:
+		slashing.SlashingStatusID = entities.SlashingStatusIDCanceled
+	default:
+		slashing.SlashingStatusID = entities.SlashingStatusIDRejected
+	}
+
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+	slashing.ResolvedDeployHash = &deployProcessed.DeployHash
+	slashing.ResolvedAt = &deployProcessed.Timestamp
+
+	return s.GetEntityManager().SlashingRepository().Update(slashing)
+}
+
+// calculateSlashedAmounts sums the reputation burned from the slashed VA and the stakes of its canceled ballots
+func (s *TrackSlashingVotingEnded) calculateSlashedAmounts(slashing *entities.Slashing) error {
+	reputationContractPackageHash := s.GetDAOContractsMetadata().ReputationContractPackageHash.ToHex()
+
+	var reputationBurned, stakesReturned uint64
+	for _, event := range s.deployEvents {
+		switch {
+		case event.Name == reputation.BurnEventName && event.ContractPackageHash.ToHex() == reputationContractPackageHash:
+			burn, err := reputation.ParseBurn(event)
+			if err != nil {
+				return err
+			}
+
+			if *burn.Address.ToHash() == slashing.AddressToSlash {
+				reputationBurned += burn.Amount.Value().Uint64()
+			}
+		case event.Name == base.BallotCanceledEventName:
+			ballotCanceled, err := base.ParseBallotCanceledEvent(event)
+			if err != nil {
+				return err
+			}
+
+			if *ballotCanceled.Voter.ToHash() == slashing.AddressToSlash {
+				stakesReturned += ballotCanceled.Stake.Value().Uint64()
+			}
+		}
+	}
+
+	slashing.ReputationBurned = &reputationBurned
+	slashing.StakesReturned = &stakesReturned
+	return nil
+}
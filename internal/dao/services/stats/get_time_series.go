@@ -0,0 +1,41 @@
+package stats
+
+import (
+	"time"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+type GetTimeSeries struct {
+	di.EntityManagerAware
+
+	metric   entities.TimeSeriesMetric
+	interval entities.TimeSeriesInterval
+	from     *time.Time
+	to       *time.Time
+}
+
+func NewGetTimeSeries() *GetTimeSeries {
+	return &GetTimeSeries{}
+}
+
+func (c *GetTimeSeries) SetMetric(metric entities.TimeSeriesMetric) {
+	c.metric = metric
+}
+
+func (c *GetTimeSeries) SetInterval(interval entities.TimeSeriesInterval) {
+	c.interval = interval
+}
+
+func (c *GetTimeSeries) SetFrom(from *time.Time) {
+	c.from = from
+}
+
+func (c *GetTimeSeries) SetTo(to *time.Time) {
+	c.to = to
+}
+
+func (c *GetTimeSeries) Execute() ([]entities.TimeSeriesPoint, error) {
+	return c.GetEntityManager().StatsRepository().CalculateTimeSeries(c.metric, c.interval, c.from, c.to)
+}
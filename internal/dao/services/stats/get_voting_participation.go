@@ -0,0 +1,42 @@
+package stats
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type GetVotingParticipation struct {
+	di.EntityManagerAware
+	di.PaginationParamsAware
+
+	votingTypeIDs []entities.VotingTypeID
+}
+
+func NewGetVotingParticipation() *GetVotingParticipation {
+	return &GetVotingParticipation{}
+}
+
+func (c *GetVotingParticipation) SetVotingTypeIDs(votingTypeIDs []entities.VotingTypeID) {
+	c.votingTypeIDs = votingTypeIDs
+}
+
+func (c *GetVotingParticipation) Execute() (*pagination.Result, error) {
+	filters := map[string]interface{}{}
+
+	if len(c.votingTypeIDs) != 0 {
+		filters["voting_type_id"] = c.votingTypeIDs
+	}
+
+	count, err := c.GetEntityManager().StatsRepository().CountVotingParticipation(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	participation, err := c.GetEntityManager().StatsRepository().FindVotingParticipation(c.GetPaginationParams(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewResult(count, c.GetPaginationParams().PageSize, participation), nil
+}
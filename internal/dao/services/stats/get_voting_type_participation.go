@@ -0,0 +1,18 @@
+package stats
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+type GetVotingTypeParticipation struct {
+	di.EntityManagerAware
+}
+
+func NewGetVotingTypeParticipation() *GetVotingTypeParticipation {
+	return &GetVotingTypeParticipation{}
+}
+
+func (c *GetVotingTypeParticipation) Execute() ([]entities.VotingTypeParticipation, error) {
+	return c.GetEntityManager().StatsRepository().CalculateVotingTypeParticipation()
+}
@@ -0,0 +1,24 @@
+package votes
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+type GetStakeTallies struct {
+	di.EntityManagerAware
+
+	votingIDs []uint32
+}
+
+func NewGetStakeTallies() *GetStakeTallies {
+	return &GetStakeTallies{}
+}
+
+func (c *GetStakeTallies) SetVotingIDs(ids []uint32) {
+	c.votingIDs = ids
+}
+
+func (c *GetStakeTallies) Execute() (map[uint32][]entities.VotingStakeTally, error) {
+	return c.GetEntityManager().VoteRepository().CalculateStakeTalliesForVotings(c.votingIDs)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/make-software/casper-go-sdk/casper"
 
 	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
 	"casper-dao-middleware/pkg/pagination"
 )
 
@@ -11,9 +12,10 @@ type GetVotes struct {
 	di.PaginationParamsAware
 	di.EntityManagerAware
 
-	votingIDs []uint32
-	isFormal  *bool
-	address   *casper.Hash
+	votingIDs    []uint32
+	isFormal     *bool
+	address      *casper.Hash
+	stakeTypeIDs []entities.StakeTypeID
 }
 
 func NewGetVotes() *GetVotes {
@@ -32,6 +34,10 @@ func (c *GetVotes) SetIsFormal(isFormal *bool) {
 	c.isFormal = isFormal
 }
 
+func (c *GetVotes) SetStakeTypeIDs(stakeTypeIDs []entities.StakeTypeID) {
+	c.stakeTypeIDs = stakeTypeIDs
+}
+
 func (c *GetVotes) Execute() (*pagination.Result, error) {
 	filters := map[string]interface{}{}
 
@@ -47,6 +53,10 @@ func (c *GetVotes) Execute() (*pagination.Result, error) {
 		filters["is_formal"] = *c.isFormal
 	}
 
+	if len(c.stakeTypeIDs) != 0 {
+		filters["stake_type_id"] = c.stakeTypeIDs
+	}
+
 	count, err := c.GetEntityManager().VoteRepository().Count(filters)
 	if err != nil {
 		return nil, err
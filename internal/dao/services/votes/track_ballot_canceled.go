@@ -8,6 +8,7 @@ import (
 type TrackCanceledVote struct {
 	di.EntityManagerAware
 	di.CESEventAware
+	di.DeployProcessedEventAware
 }
 
 func NewTrackCanceledVote() *TrackCanceledVote {
@@ -20,5 +21,6 @@ func (s *TrackCanceledVote) Execute() error {
 		return err
 	}
 
-	return s.GetEntityManager().VoteRepository().UpdateIsCanceled(ballotCanceled.VotingID, *ballotCanceled.Voter.ToHash(), true)
+	canceledAt := s.GetDeployProcessedEvent().DeployProcessed.Timestamp
+	return s.GetEntityManager().VoteRepository().UpdateIsCanceled(ballotCanceled.VotingID, *ballotCanceled.Voter.ToHash(), true, &canceledAt)
 }
@@ -33,22 +33,18 @@ func (s *TrackVote) Execute() error {
 		return err
 	}
 
-	if err := s.saveVote(ballotCast); err != nil {
+	stakeTypeID, err := s.resolveStakeType(ballotCast)
+	if err != nil {
+		return err
+	}
+
+	if err := s.saveVote(ballotCast, stakeTypeID); err != nil {
 		return err
 	}
 
-	// in case of Non VA vote in BidEscrow we should not calculate reputations history as
-	// Non VA provide capers
-	if s.GetDAOContractsMetadata().BidEscrowContractPackageHash.String() == s.voterContractPackageHash.String() {
-		account, err := s.GetEntityManager().AccountRepository().FindByHash(*ballotCast.Voter.ToHash())
-		if err != nil {
-			return err
-		}
-
-		if !account.IsVA {
-			zap.S().Infow("Not collecting reputation changes for Non VA in BidEscrow")
-			return nil
-		}
+	if stakeTypeID == entities.StakeTypeIDCSPR {
+		zap.S().Infow("Not collecting reputation changes for Non VA in BidEscrow")
+		return nil
 	}
 
 	if err := s.collectReputationChanges(ballotCast, s.voterContractPackageHash); err != nil {
@@ -62,7 +58,26 @@ func (s *TrackVote) Execute() error {
 	return nil
 }
 
-func (s *TrackVote) saveVote(ballotCast base.BallotCastEvent) error {
+// resolveStakeType detects what is staked in the ballot, in case of Non VA vote in BidEscrow
+// we should not calculate reputations history as Non VA provide caspers
+func (s *TrackVote) resolveStakeType(ballotCast base.BallotCastEvent) (entities.StakeTypeID, error) {
+	if s.GetDAOContractsMetadata().BidEscrowContractPackageHash.String() != s.voterContractPackageHash.String() {
+		return entities.StakeTypeIDReputation, nil
+	}
+
+	account, err := s.GetEntityManager().AccountRepository().FindByHash(*ballotCast.Voter.ToHash())
+	if err != nil {
+		return 0, err
+	}
+
+	if !account.IsVA {
+		return entities.StakeTypeIDCSPR, nil
+	}
+
+	return entities.StakeTypeIDReputation, nil
+}
+
+func (s *TrackVote) saveVote(ballotCast base.BallotCastEvent, stakeTypeID entities.StakeTypeID) error {
 	staked := ballotCast.Stake.Value().Int64()
 
 	var isInFavor bool
@@ -78,6 +93,7 @@ func (s *TrackVote) saveVote(ballotCast base.BallotCastEvent) error {
 		deployProcessedEvent.DeployProcessed.DeployHash,
 		ballotCast.VotingID,
 		uint64(staked),
+		stakeTypeID,
 		isInFavor,
 		isFormal,
 		deployProcessedEvent.DeployProcessed.Timestamp)
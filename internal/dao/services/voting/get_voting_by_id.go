@@ -0,0 +1,24 @@
+package voting
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+type GetVotingByID struct {
+	di.EntityManagerAware
+
+	votingID uint32
+}
+
+func NewGetVotingByID() *GetVotingByID {
+	return &GetVotingByID{}
+}
+
+func (c *GetVotingByID) SetVotingID(votingID uint32) {
+	c.votingID = votingID
+}
+
+func (c *GetVotingByID) Execute() (*entities.Voting, error) {
+	return c.GetEntityManager().VotingRepository().GetByVotingID(c.votingID)
+}
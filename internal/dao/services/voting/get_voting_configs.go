@@ -0,0 +1,24 @@
+package voting
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+type GetVotingConfigs struct {
+	di.EntityManagerAware
+
+	votingIDs []uint32
+}
+
+func NewGetVotingConfigs() *GetVotingConfigs {
+	return &GetVotingConfigs{}
+}
+
+func (c *GetVotingConfigs) SetVotingIDs(ids []uint32) {
+	c.votingIDs = ids
+}
+
+func (c *GetVotingConfigs) Execute() ([]entities.VotingConfig, error) {
+	return c.GetEntityManager().VotingConfigRepository().FindByVotingIDs(c.votingIDs)
+}
@@ -0,0 +1,24 @@
+package voting
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+type GetVotingEffects struct {
+	di.EntityManagerAware
+
+	votingIDs []uint32
+}
+
+func NewGetVotingEffects() *GetVotingEffects {
+	return &GetVotingEffects{}
+}
+
+func (c *GetVotingEffects) SetVotingIDs(ids []uint32) {
+	c.votingIDs = ids
+}
+
+func (c *GetVotingEffects) Execute() ([]entities.VotingEffect, error) {
+	return c.GetEntityManager().VotingEffectRepository().FindByVotingIDs(c.votingIDs)
+}
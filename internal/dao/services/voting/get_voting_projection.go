@@ -0,0 +1,90 @@
+package voting
+
+import (
+	"time"
+
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+)
+
+type GetVotingProjection struct {
+	di.EntityManagerAware
+
+	votingID uint32
+}
+
+func NewGetVotingProjection() *GetVotingProjection {
+	return &GetVotingProjection{}
+}
+
+func (c *GetVotingProjection) SetVotingID(votingID uint32) {
+	c.votingID = votingID
+}
+
+func (c *GetVotingProjection) Execute() (*entities.VotingProjection, error) {
+	voting, err := c.GetEntityManager().VotingRepository().GetByVotingID(c.votingID)
+	if err != nil {
+		return nil, err
+	}
+
+	// formal voting follows the ended informal one
+	isFormal := voting.InformalVotingResult != nil
+
+	tally, err := c.GetEntityManager().VoteRepository().CalculateStageTally(voting.VotingID, isFormal)
+	if err != nil {
+		return nil, err
+	}
+
+	projection := entities.VotingProjection{
+		VotingID:         voting.VotingID,
+		IsFormal:         isFormal,
+		Quorum:           voting.InformalVotingQuorum,
+		TotalOnboarded:   voting.ConfigTotalOnboarded,
+		VotingStageTally: *tally,
+	}
+
+	if isFormal {
+		projection.Quorum = voting.FormalVotingQuorum
+	}
+
+	// formal voting period is stored on the Voting creation when the time between votings can not be doubled,
+	// otherwise it is stored when the informal voting is ended
+	if voting.FormalVotingStartsAt != nil {
+		projection.FormalVotingStartsAt = voting.FormalVotingStartsAt
+		projection.FormalVotingEndsAt = voting.FormalVotingEndsAt
+		timeBetweenVotings := voting.FormalVotingStartsAt.Sub(voting.InformalVotingEndsAt)
+		projection.IsTimeBetweenVotingsDoubled = timeBetweenVotings > time.Millisecond*time.Duration(voting.ConfigTimeBetweenInformalAndFormalVoting)
+	} else {
+		formalStartsAt, formalEndsAt := voting.CalculateFormalVotingPeriod(tally.VotesInFavor, tally.VotesAgainst)
+		projection.FormalVotingStartsAt = &formalStartsAt
+		projection.FormalVotingEndsAt = &formalEndsAt
+		projection.IsTimeBetweenVotingsDoubled = !voting.IsTimeBetweenVotingsDefault(tally.VotesInFavor, tally.VotesAgainst)
+	}
+
+	votesNumber := tally.VotesInFavor + tally.VotesAgainst
+	if votesNumber < projection.Quorum {
+		projection.VotesToQuorum = projection.Quorum - votesNumber
+	}
+
+	projection.IsQuorumReachable = uint64(votesNumber)+uint64(projection.VotesToQuorum) <= voting.ConfigTotalOnboarded
+
+	// the contract resolves the voting in favor only when more reputation is staked in favor than against
+	if tally.StakeInFavor > tally.StakeAgainst {
+		projection.StakeToFlip = tally.StakeInFavor - tally.StakeAgainst
+	} else {
+		projection.StakeToFlip = tally.StakeAgainst - tally.StakeInFavor + 1
+	}
+
+	switch {
+	case voting.IsCanceled:
+		projection.Result = entities.VotingResultCanceled
+	case projection.VotesToQuorum > 0:
+		projection.Result = entities.VotingResultQuorumNotReached
+	case tally.StakeInFavor > tally.StakeAgainst:
+		projection.Result = entities.VotingResultInFavor
+	default:
+		projection.Result = entities.VotingResultAgainst
+	}
+
+	return &projection, nil
+}
@@ -9,7 +9,8 @@ type GetVotings struct {
 	di.PaginationParamsAware
 	di.EntityManagerAware
 
-	votingIDs []uint32
+	votingIDs   []uint32
+	jobOfferIDs []uint32
 }
 
 func NewGetVotings() *GetVotings {
@@ -20,6 +21,10 @@ func (c *GetVotings) SetVotingIDs(ids []uint32) {
 	c.votingIDs = ids
 }
 
+func (c *GetVotings) SetJobOfferIDs(ids []uint32) {
+	c.jobOfferIDs = ids
+}
+
 func (c *GetVotings) Execute() (*pagination.Result, error) {
 	filters := map[string]interface{}{}
 
@@ -27,6 +32,10 @@ func (c *GetVotings) Execute() (*pagination.Result, error) {
 		filters["voting_id"] = c.votingIDs
 	}
 
+	if len(c.jobOfferIDs) != 0 {
+		filters["job_offer_id"] = c.jobOfferIDs
+	}
+
 	count, err := c.GetEntityManager().VotingRepository().Count(filters)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,54 @@
+package voting
+
+import (
+	"sort"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/persistence"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+// saveVotingConfig stores the Voting config with the snapshot of the settings effective at the config timestamp,
+// the settings history is preferred over the current settings as the deploy could be processed later than created
+func saveVotingConfig(entityManager persistence.EntityManager, config entities.VotingConfig) error {
+	filters := map[string]interface{}{}
+	count, err := entityManager.SettingRepository().Count(filters)
+	if err != nil {
+		return err
+	}
+
+	storedSettings, err := entityManager.SettingRepository().Find(&pagination.Params{Page: 1, PageSize: count}, filters)
+	if err != nil {
+		return err
+	}
+
+	settingsMap := make(map[string]entities.Setting, len(storedSettings))
+	for _, setting := range storedSettings {
+		setting.ApplyPendingValue(config.Timestamp)
+		settingsMap[setting.Name] = *setting
+	}
+
+	changes, err := entityManager.SettingChangeRepository().FindEffectiveAt(config.Timestamp)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		settingsMap[change.Name] = entities.NewSetting(change.Name, change.Value, change.ValueTypeID, nil, nil)
+	}
+
+	settings := make([]entities.Setting, 0, len(settingsMap))
+	for _, setting := range settingsMap {
+		settings = append(settings, setting)
+	}
+	sort.Slice(settings, func(i, j int) bool {
+		return settings[i].Name < settings[j].Name
+	})
+
+	config.Settings, err = entities.NewSettingsSnapshot(settings)
+	if err != nil {
+		return err
+	}
+
+	return entityManager.VotingConfigRepository().Save(&config)
+}
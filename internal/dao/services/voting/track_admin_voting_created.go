@@ -69,5 +69,22 @@ func (s *TrackAdminVotingCreated) Execute() error {
 		adminVotingCreatedEvent.ConfigTimeBetweenInformalAndFormalVoting,
 	)
 
-	return s.GetEntityManager().VotingRepository().Save(&voting)
+	if err := s.GetEntityManager().VotingRepository().Save(&voting); err != nil {
+		return err
+	}
+
+	votingConfig := entities.NewVotingConfig(
+		adminVotingCreatedEvent.VotingID,
+		adminVotingCreatedEvent.ConfigInformalQuorum,
+		adminVotingCreatedEvent.ConfigInformalVotingTime,
+		adminVotingCreatedEvent.ConfigFormalQuorum,
+		adminVotingCreatedEvent.ConfigFormalVotingTime,
+		adminVotingCreatedEvent.ConfigTotalOnboarded.Value().Uint64(),
+		adminVotingCreatedEvent.ConfigDoubleTimeBetweenVotings,
+		adminVotingCreatedEvent.ConfigVotingClearnessDelta.Value().Uint64(),
+		adminVotingCreatedEvent.ConfigTimeBetweenInformalAndFormalVoting,
+		s.GetDeployProcessedEvent().DeployProcessed.Timestamp,
+	)
+
+	return saveVotingConfig(s.GetEntityManager(), votingConfig)
 }
@@ -83,5 +83,22 @@ func (s *TrackBidEscrowVotingCreated) Execute() error {
 		bidEscrowVotingCreated.ConfigTimeBetweenInformalAndFormalVoting,
 	)
 
-	return s.GetEntityManager().VotingRepository().Save(&voting)
+	if err := s.GetEntityManager().VotingRepository().Save(&voting); err != nil {
+		return err
+	}
+
+	votingConfig := entities.NewVotingConfig(
+		bidEscrowVotingCreated.VotingID,
+		bidEscrowVotingCreated.ConfigInformalQuorum,
+		bidEscrowVotingCreated.ConfigInformalVotingTime,
+		bidEscrowVotingCreated.ConfigFormalQuorum,
+		bidEscrowVotingCreated.ConfigFormalVotingTime,
+		bidEscrowVotingCreated.ConfigTotalOnboarded.Value().Uint64(),
+		bidEscrowVotingCreated.ConfigDoubleTimeBetweenVotings,
+		bidEscrowVotingCreated.ConfigVotingClearnessDelta.Value().Uint64(),
+		bidEscrowVotingCreated.ConfigTimeBetweenInformalAndFormalVoting,
+		s.GetDeployProcessedEvent().DeployProcessed.Timestamp,
+	)
+
+	return saveVotingConfig(s.GetEntityManager(), votingConfig)
 }
@@ -68,5 +68,22 @@ func (s *TrackKycVotingCreated) Execute() error {
 		kycVotingCreated.ConfigTimeBetweenInformalAndFormalVoting,
 	)
 
-	return s.GetEntityManager().VotingRepository().Save(&voting)
+	if err := s.GetEntityManager().VotingRepository().Save(&voting); err != nil {
+		return err
+	}
+
+	votingConfig := entities.NewVotingConfig(
+		kycVotingCreated.VotingID,
+		kycVotingCreated.ConfigInformalQuorum,
+		kycVotingCreated.ConfigInformalVotingTime,
+		kycVotingCreated.ConfigFormalQuorum,
+		kycVotingCreated.ConfigFormalVotingTime,
+		kycVotingCreated.ConfigTotalOnboarded.Value().Uint64(),
+		kycVotingCreated.ConfigDoubleTimeBetweenVotings,
+		kycVotingCreated.ConfigVotingClearnessDelta.Value().Uint64(),
+		kycVotingCreated.ConfigTimeBetweenInformalAndFormalVoting,
+		s.GetDeployProcessedEvent().DeployProcessed.Timestamp,
+	)
+
+	return saveVotingConfig(s.GetEntityManager(), votingConfig)
 }
@@ -68,5 +68,36 @@ func (s *TrackOnboardingVotingCreated) Execute() error {
 		onboardingRequestVotingCreatedEvent.ConfigTimeBetweenInformalAndFormalVoting,
 	)
 
-	return s.GetEntityManager().VotingRepository().Save(&voting)
+	if err := s.GetEntityManager().VotingRepository().Save(&voting); err != nil {
+		return err
+	}
+
+	votingConfig := entities.NewVotingConfig(
+		onboardingRequestVotingCreatedEvent.VotingID,
+		onboardingRequestVotingCreatedEvent.ConfigInformalQuorum,
+		onboardingRequestVotingCreatedEvent.ConfigInformalVotingTime,
+		onboardingRequestVotingCreatedEvent.ConfigFormalQuorum,
+		onboardingRequestVotingCreatedEvent.ConfigFormalVotingTime,
+		onboardingRequestVotingCreatedEvent.ConfigTotalOnboarded.Value().Uint64(),
+		onboardingRequestVotingCreatedEvent.ConfigDoubleTimeBetweenVotings,
+		onboardingRequestVotingCreatedEvent.ConfigVotingClearnessDelta.Value().Uint64(),
+		onboardingRequestVotingCreatedEvent.ConfigTimeBetweenInformalAndFormalVoting,
+		s.GetDeployProcessedEvent().DeployProcessed.Timestamp,
+	)
+
+	if err := saveVotingConfig(s.GetEntityManager(), votingConfig); err != nil {
+		return err
+	}
+
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+	request := entities.NewOnboardingRequest(
+		onboardingRequestVotingCreatedEvent.VotingID,
+		*onboardingRequestVotingCreatedEvent.Creator.ToHash(),
+		onboardingRequestVotingCreatedEvent.Reason,
+		onboardingRequestVotingCreatedEvent.CsprDeposit.Value().Uint64(),
+		deployProcessed.DeployHash,
+		deployProcessed.Timestamp,
+	)
+
+	return s.GetEntityManager().OnboardingRequestRepository().Save(&request)
 }
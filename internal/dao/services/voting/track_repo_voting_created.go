@@ -7,6 +7,7 @@ import (
 	"casper-dao-middleware/internal/dao/di"
 	"casper-dao-middleware/internal/dao/entities"
 	"casper-dao-middleware/internal/dao/events/repo_voter"
+	"casper-dao-middleware/internal/dao/types"
 )
 
 type TrackRepoVotingCreated struct {
@@ -25,10 +26,21 @@ func (s *TrackRepoVotingCreated) Execute() error {
 		return err
 	}
 
+	var proposedValue *string
+	var valueTypeID *entities.SettingValueTypeID
+
+	// the proposed value is serialized in the same way as Variable Repository value
+	if recordValue, err := types.NewRecordValueFromBytes(repoVotingCreatedEvent.Value); err == nil {
+		value := recordValue.String()
+		proposedValue = &value
+		valueTypeID = entities.NewSettingValueTypeID(recordValue)
+	}
+
 	metadata := map[string]interface{}{
 		"variable_repo_to_edit": repoVotingCreatedEvent.VariableRepoToEdit.ToHash().ToHex(),
 		"key":                   repoVotingCreatedEvent.Key,
 		"value":                 string(repoVotingCreatedEvent.Value),
+		"decoded_value":         proposedValue,
 		"activation_time":       repoVotingCreatedEvent.ActivationTime,
 	}
 
@@ -70,5 +82,44 @@ func (s *TrackRepoVotingCreated) Execute() error {
 		repoVotingCreatedEvent.ConfigTimeBetweenInformalAndFormalVoting,
 	)
 
-	return s.GetEntityManager().VotingRepository().Save(&voting)
+	if err := s.GetEntityManager().VotingRepository().Save(&voting); err != nil {
+		return err
+	}
+
+	votingConfig := entities.NewVotingConfig(
+		repoVotingCreatedEvent.VotingID,
+		repoVotingCreatedEvent.ConfigInformalQuorum,
+		repoVotingCreatedEvent.ConfigInformalVotingTime,
+		repoVotingCreatedEvent.ConfigFormalQuorum,
+		repoVotingCreatedEvent.ConfigFormalVotingTime,
+		repoVotingCreatedEvent.ConfigTotalOnboarded.Value().Uint64(),
+		repoVotingCreatedEvent.ConfigDoubleTimeBetweenVotings,
+		repoVotingCreatedEvent.ConfigVotingClearnessDelta.Value().Uint64(),
+		repoVotingCreatedEvent.ConfigTimeBetweenInformalAndFormalVoting,
+		s.GetDeployProcessedEvent().DeployProcessed.Timestamp,
+	)
+
+	if err := saveVotingConfig(s.GetEntityManager(), votingConfig); err != nil {
+		return err
+	}
+
+	var activationTime *time.Time
+	if repoVotingCreatedEvent.ActivationTime != nil {
+		activation := time.UnixMilli(int64(*repoVotingCreatedEvent.ActivationTime)).UTC()
+		activationTime = &activation
+	}
+
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+	proposal := entities.NewSettingProposal(
+		repoVotingCreatedEvent.VotingID,
+		repoVotingCreatedEvent.Key,
+		*repoVotingCreatedEvent.VariableRepoToEdit.ToHash(),
+		proposedValue,
+		valueTypeID,
+		activationTime,
+		deployProcessed.DeployHash,
+		deployProcessed.Timestamp,
+	)
+
+	return s.GetEntityManager().SettingProposalRepository().Save(&proposal)
 }
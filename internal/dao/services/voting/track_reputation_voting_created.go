@@ -70,5 +70,22 @@ func (s *TrackReputationVotingCreated) Execute() error {
 		reputationVotingCreated.ConfigTimeBetweenInformalAndFormalVoting,
 	)
 
-	return s.GetEntityManager().VotingRepository().Save(&voting)
+	if err := s.GetEntityManager().VotingRepository().Save(&voting); err != nil {
+		return err
+	}
+
+	votingConfig := entities.NewVotingConfig(
+		reputationVotingCreated.VotingID,
+		reputationVotingCreated.ConfigInformalQuorum,
+		reputationVotingCreated.ConfigInformalVotingTime,
+		reputationVotingCreated.ConfigFormalQuorum,
+		reputationVotingCreated.ConfigFormalVotingTime,
+		reputationVotingCreated.ConfigTotalOnboarded.Value().Uint64(),
+		reputationVotingCreated.ConfigDoubleTimeBetweenVotings,
+		reputationVotingCreated.ConfigVotingClearnessDelta.Value().Uint64(),
+		reputationVotingCreated.ConfigTimeBetweenInformalAndFormalVoting,
+		s.GetDeployProcessedEvent().DeployProcessed.Timestamp,
+	)
+
+	return saveVotingConfig(s.GetEntityManager(), votingConfig)
 }
@@ -67,5 +67,22 @@ func (s *TrackSimpleVotingCreated) Execute() error {
 		simpleVotingCreated.ConfigTimeBetweenInformalAndFormalVoting,
 	)
 
-	return s.GetEntityManager().VotingRepository().Save(&voting)
+	if err := s.GetEntityManager().VotingRepository().Save(&voting); err != nil {
+		return err
+	}
+
+	votingConfig := entities.NewVotingConfig(
+		simpleVotingCreated.VotingID,
+		simpleVotingCreated.ConfigInformalQuorum,
+		simpleVotingCreated.ConfigInformalVotingTime,
+		simpleVotingCreated.ConfigFormalQuorum,
+		simpleVotingCreated.ConfigFormalVotingTime,
+		simpleVotingCreated.ConfigTotalOnboarded.Value().Uint64(),
+		simpleVotingCreated.ConfigDoubleTimeBetweenVotings,
+		simpleVotingCreated.ConfigVotingClearnessDelta.Value().Uint64(),
+		simpleVotingCreated.ConfigTimeBetweenInformalAndFormalVoting,
+		s.GetDeployProcessedEvent().DeployProcessed.Timestamp,
+	)
+
+	return saveVotingConfig(s.GetEntityManager(), votingConfig)
 }
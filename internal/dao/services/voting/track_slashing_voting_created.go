@@ -68,5 +68,36 @@ func (s *TrackSlashingVotingCreated) Execute() error {
 		slashingVotingCreatedEvent.ConfigTimeBetweenInformalAndFormalVoting,
 	)
 
-	return s.GetEntityManager().VotingRepository().Save(&voting)
+	if err := s.GetEntityManager().VotingRepository().Save(&voting); err != nil {
+		return err
+	}
+
+	votingConfig := entities.NewVotingConfig(
+		slashingVotingCreatedEvent.VotingID,
+		slashingVotingCreatedEvent.ConfigInformalQuorum,
+		slashingVotingCreatedEvent.ConfigInformalVotingTime,
+		slashingVotingCreatedEvent.ConfigFormalQuorum,
+		slashingVotingCreatedEvent.ConfigFormalVotingTime,
+		slashingVotingCreatedEvent.ConfigTotalOnboarded.Value().Uint64(),
+		slashingVotingCreatedEvent.ConfigDoubleTimeBetweenVotings,
+		slashingVotingCreatedEvent.ConfigVotingClearnessDelta.Value().Uint64(),
+		slashingVotingCreatedEvent.ConfigTimeBetweenInformalAndFormalVoting,
+		s.GetDeployProcessedEvent().DeployProcessed.Timestamp,
+	)
+
+	if err := saveVotingConfig(s.GetEntityManager(), votingConfig); err != nil {
+		return err
+	}
+
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+	slashing := entities.NewSlashing(
+		slashingVotingCreatedEvent.VotingID,
+		*slashingVotingCreatedEvent.AddressToSlash.ToHash(),
+		slashingVotingCreatedEvent.SlashRation,
+		*slashingVotingCreatedEvent.Creator.ToHash(),
+		deployProcessed.DeployHash,
+		deployProcessed.Timestamp,
+	)
+
+	return s.GetEntityManager().SlashingRepository().Save(&slashing)
 }
@@ -0,0 +1,109 @@
+package voting
+
+import (
+	"casper-dao-middleware/internal/dao/di"
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/events/kyc_nft"
+	"casper-dao-middleware/internal/dao/events/reputation"
+	"casper-dao-middleware/internal/dao/events/va_nft"
+	"casper-dao-middleware/internal/dao/types"
+)
+
+// TrackVotingEffect links the event emitted by the action of the passed governance Voting to this Voting
+type TrackVotingEffect struct {
+	di.EntityManagerAware
+	di.CESEventAware
+	di.DeployProcessedEventAware
+	di.DAOContractsMetadataAware
+
+	votingID uint32
+}
+
+func NewTrackVotingEffect() *TrackVotingEffect {
+	return &TrackVotingEffect{}
+}
+
+func (s *TrackVotingEffect) SetVotingID(votingID uint32) {
+	s.votingID = votingID
+}
+
+func (s *TrackVotingEffect) Execute() error {
+	cesEvent := s.GetCESEvent()
+	daoContractsMetadata := s.GetDAOContractsMetadata()
+
+	var (
+		effectTypeID    entities.VotingEffectTypeID
+		address         *types.Address
+		amount, tokenID *uint64
+	)
+
+	switch cesEvent.ContractPackageHash.ToHex() {
+	case daoContractsMetadata.ReputationContractPackageHash.ToHex():
+		switch cesEvent.Name {
+		case reputation.MintEventName:
+			mint, err := reputation.ParseMint(cesEvent)
+			if err != nil {
+				return err
+			}
+			minted := mint.Amount.Value().Uint64()
+			effectTypeID, address, amount = entities.VotingEffectTypeIDReputationMinted, &mint.Address, &minted
+		case reputation.BurnEventName:
+			burn, err := reputation.ParseBurn(cesEvent)
+			if err != nil {
+				return err
+			}
+			burned := burn.Amount.Value().Uint64()
+			effectTypeID, address, amount = entities.VotingEffectTypeIDReputationBurned, &burn.Address, &burned
+		default:
+			return nil
+		}
+	case daoContractsMetadata.VANFTContractPackageHash.ToHex():
+		transfer, err := va_nft.ParseTransferEvent(cesEvent)
+		if err != nil {
+			return err
+		}
+		token := transfer.TokenID.Value().Uint64()
+		tokenID = &token
+
+		switch {
+		case transfer.From == nil && transfer.To != nil:
+			effectTypeID, address = entities.VotingEffectTypeIDVAGranted, transfer.To
+		case transfer.From != nil && transfer.To == nil:
+			effectTypeID, address = entities.VotingEffectTypeIDVARevoked, transfer.From
+		default:
+			return nil
+		}
+	case daoContractsMetadata.KycNFTContractPackageHash.ToHex():
+		transfer, err := kyc_nft.ParseTransferEvent(cesEvent)
+		if err != nil {
+			return err
+		}
+		token := transfer.TokenID.Value().Uint64()
+		tokenID = &token
+
+		switch {
+		case transfer.From == nil && transfer.To != nil:
+			effectTypeID, address = entities.VotingEffectTypeIDKYCGranted, transfer.To
+		case transfer.From != nil && transfer.To == nil:
+			effectTypeID, address = entities.VotingEffectTypeIDKYCRevoked, transfer.From
+		default:
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	deployProcessed := s.GetDeployProcessedEvent().DeployProcessed
+	effect := entities.NewVotingEffect(
+		s.votingID,
+		effectTypeID,
+		*address.ToHash(),
+		amount,
+		tokenID,
+		deployProcessed.DeployHash,
+		cesEvent.TransformID,
+		deployProcessed.Timestamp,
+	)
+
+	return s.GetEntityManager().VotingEffectRepository().Save(&effect)
+}
@@ -1,15 +1,12 @@
 package voting
 
 import (
-	"time"
-
 	"github.com/make-software/casper-go-sdk/casper"
 
 	"casper-dao-middleware/internal/dao/di"
 	"casper-dao-middleware/internal/dao/entities"
 	"casper-dao-middleware/internal/dao/events/base"
 	"casper-dao-middleware/internal/dao/types"
-	"casper-dao-middleware/internal/dao/utils"
 )
 
 type TrackVotingEnded struct {
@@ -47,9 +44,47 @@ func (s *TrackVotingEnded) Execute() error {
 		return err
 	}
 
+	if err := s.updateVoteOutcomes(votingEnded); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+func (s *TrackVotingEnded) updateVoteOutcomes(votingEnded base.VotingEndedEvent) error {
+	outcomesMap := make(map[string]*entities.VoteOutcome)
+
+	getOutcome := func(key types.Tuple2) *entities.VoteOutcome {
+		address, _ := casper.NewHash(key.Element1)
+		outcome, ok := outcomesMap[address.ToHex()]
+		if !ok {
+			outcome = &entities.VoteOutcome{Address: address}
+			outcomesMap[address.ToHex()] = outcome
+		}
+		return outcome
+	}
+
+	for key, val := range votingEnded.Unstakes {
+		getOutcome(key).StakeReturned = val.Value().Uint64()
+	}
+
+	for key, val := range votingEnded.Mints {
+		getOutcome(key).ReputationEarned = val.Value().Uint64()
+	}
+
+	for key, val := range votingEnded.Burns {
+		getOutcome(key).ReputationBurned = val.Value().Uint64()
+	}
+
+	outcomes := make([]entities.VoteOutcome, 0, len(outcomesMap))
+	for _, outcome := range outcomesMap {
+		outcomes = append(outcomes, *outcome)
+	}
+
+	isFormal := votingEnded.VotingType == types.VotingTypeFormal
+	return s.GetEntityManager().VoteRepository().UpdateOutcomes(votingEnded.VotingID, isFormal, outcomes)
+}
+
 func (s *TrackVotingEnded) collectReputationChanges(votingEnded base.VotingEndedEvent, voterContractPackageHash casper.ContractPackageHash) error {
 	changes := make([]entities.ReputationChange, 0, len(votingEnded.Burns)+len(votingEnded.Mints)+len(votingEnded.Unstakes)*2)
 	deployProcessedEvent := s.GetDeployProcessedEvent()
@@ -236,22 +271,7 @@ func (s *TrackVotingEnded) updateVotingState(votingEnded base.VotingEndedEvent)
 
 	// we need to calculate FormalVotingStarts/FormalVotingEnds based on the VotingEnded result
 	if storedVoting.FormalVotingStartsAt == nil {
-		var formalStartsAt, formalEndsAt time.Time
-		var allVotesCount = votingEnded.VotesInFavor + votingEnded.VotesAgainst
-		var inFavourPercent = utils.PercentOf(votingEnded.VotesInFavor, allVotesCount)
-
-		//This behavior is configured using VotingClearnessDelta Governance Variable.
-		//It is a numeric value which tells how far from 50/50 result can be in percent points, before the time will be doubled.
-		//For example, when VotingClearnessDelta is set to 8 and the result of the Informal Voting is 42 percent "for" and 58 "against" then the time between votings should be doubled.
-		//When the result is 41/59, the default value of time will be used.
-		if 50-inFavourPercent > float64(storedVoting.ConfigVotingClearnessDelta) {
-			formalStartsAt = storedVoting.InformalVotingEndsAt.Add(time.Millisecond * time.Duration(storedVoting.ConfigTimeBetweenInformalAndFormalVoting))
-			formalEndsAt = formalStartsAt.Add(time.Millisecond * time.Duration(storedVoting.FormalVotingTime))
-		} else {
-			formalStartsAt = storedVoting.InformalVotingEndsAt.Add(time.Millisecond * time.Duration(storedVoting.ConfigTimeBetweenInformalAndFormalVoting*2))
-			formalEndsAt = formalStartsAt.Add(time.Millisecond * time.Duration(storedVoting.FormalVotingTime))
-		}
-
+		formalStartsAt, formalEndsAt := storedVoting.CalculateFormalVotingPeriod(votingEnded.VotesInFavor, votingEnded.VotesAgainst)
 		storedVoting.FormalVotingStartsAt = &formalStartsAt
 		storedVoting.FormalVotingEndsAt = &formalEndsAt
 	}
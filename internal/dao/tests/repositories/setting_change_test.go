@@ -0,0 +1,132 @@
+//go:build integration
+// +build integration
+
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/make-software/casper-go-sdk/casper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/persistence"
+	"casper-dao-middleware/internal/dao/utils"
+	"casper-dao-middleware/pkg/boot"
+)
+
+type SettingChangeTestSuit struct {
+	suite.Suite
+
+	db            *sqlx.DB
+	entityManager persistence.EntityManager
+
+	startedAt time.Time
+}
+
+func (suite *SettingChangeTestSuit) SetupSuite() {
+	suite.db = boot.SetUpTestDB()
+	suite.entityManager = persistence.NewEntityManager(suite.db, utils.DAOContractsMetadata{})
+
+	suite.startedAt = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (suite *SettingChangeTestSuit) SetupTest() {
+	_, err := suite.db.Exec(`TRUNCATE TABLE setting_changes`)
+	suite.NoError(err)
+}
+
+// at returns the moment of the test timeline, the changes are announced and activated at whole hours
+func (suite *SettingChangeTestSuit) at(hour int) time.Time {
+	return suite.startedAt.Add(time.Duration(hour) * time.Hour)
+}
+
+func (suite *SettingChangeTestSuit) saveChange(name, value string, activationHour *int, deployHash casper.Hash, hour int) {
+	var activationTime *time.Time
+	if activationHour != nil {
+		activation := suite.at(*activationHour)
+		activationTime = &activation
+	}
+
+	change := entities.NewSettingChange(name, value, nil, activationTime, deployHash, suite.at(hour))
+	err := suite.entityManager.SettingChangeRepository().Save(&change)
+	require.NoError(suite.T(), err)
+}
+
+func (suite *SettingChangeTestSuit) TestScheduledChangeReplacedByImmediateUpdate() {
+	activationHour := 5
+
+	suite.saveChange("PostJobDOSFee", "10", nil, casper.Hash{1}, 0)
+	suite.saveChange("PostJobDOSFee", "20", &activationHour, casper.Hash{2}, 1)
+	suite.saveChange("PostJobDOSFee", "30", nil, casper.Hash{3}, 3)
+
+	repo := suite.entityManager.SettingChangeRepository()
+
+	change, err := repo.GetEffectiveAt("PostJobDOSFee", suite.at(2))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "10", change.Value)
+
+	change, err = repo.GetEffectiveAt("PostJobDOSFee", suite.at(6))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "30", change.Value)
+}
+
+func (suite *SettingChangeTestSuit) TestScheduledChangeReplacedByReschedule() {
+	activationHour := 5
+	rescheduledActivationHour := 7
+
+	suite.saveChange("PostJobDOSFee", "10", nil, casper.Hash{1}, 0)
+	suite.saveChange("PostJobDOSFee", "20", &activationHour, casper.Hash{2}, 1)
+	suite.saveChange("PostJobDOSFee", "40", &rescheduledActivationHour, casper.Hash{4}, 4)
+
+	repo := suite.entityManager.SettingChangeRepository()
+
+	change, err := repo.GetEffectiveAt("PostJobDOSFee", suite.at(6))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "10", change.Value)
+
+	change, err = repo.GetEffectiveAt("PostJobDOSFee", suite.at(8))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "40", change.Value)
+}
+
+func (suite *SettingChangeTestSuit) TestFindEffectiveAt() {
+	activationHour := 5
+	rescheduledActivationHour := 7
+
+	// replaced by the immediate update
+	suite.saveChange("PostJobDOSFee", "10", nil, casper.Hash{1}, 0)
+	suite.saveChange("PostJobDOSFee", "20", &activationHour, casper.Hash{2}, 1)
+	suite.saveChange("PostJobDOSFee", "30", nil, casper.Hash{3}, 3)
+
+	// replaced by the reschedule
+	suite.saveChange("BidEscrowPaymentRatio", "100", nil, casper.Hash{1}, 0)
+	suite.saveChange("BidEscrowPaymentRatio", "200", &activationHour, casper.Hash{2}, 1)
+	suite.saveChange("BidEscrowPaymentRatio", "400", &rescheduledActivationHour, casper.Hash{4}, 4)
+
+	// activated as scheduled
+	suite.saveChange("VotingClearnessDelta", "8", nil, casper.Hash{1}, 0)
+	suite.saveChange("VotingClearnessDelta", "10", &activationHour, casper.Hash{2}, 1)
+
+	changes, err := suite.entityManager.SettingChangeRepository().FindEffectiveAt(suite.at(6))
+	require.NoError(suite.T(), err)
+
+	values := make(map[string]string)
+	for _, change := range changes {
+		values[change.Name] = change.Value
+	}
+
+	assert.Equal(suite.T(), map[string]string{
+		"PostJobDOSFee":         "30",
+		"BidEscrowPaymentRatio": "100",
+		"VotingClearnessDelta":  "10",
+	}, values)
+}
+
+func TestSettingChangeTestSuit(t *testing.T) {
+	suite.Run(t, new(SettingChangeTestSuit))
+}
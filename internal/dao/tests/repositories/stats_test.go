@@ -0,0 +1,245 @@
+//go:build integration
+// +build integration
+
+package repositories
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/make-software/casper-go-sdk/casper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"casper-dao-middleware/internal/dao/entities"
+	"casper-dao-middleware/internal/dao/persistence"
+	"casper-dao-middleware/internal/dao/utils"
+	"casper-dao-middleware/pkg/boot"
+	"casper-dao-middleware/pkg/pagination"
+)
+
+type StatsTestSuit struct {
+	suite.Suite
+
+	db            *sqlx.DB
+	entityManager persistence.EntityManager
+
+	reputationContractPackageHash  casper.ContractPackageHash
+	simpleVoterContractPackageHash casper.ContractPackageHash
+	bidEscrowContractPackageHash   casper.ContractPackageHash
+
+	informalVotingEndsAt time.Time
+	formalVotingEndsAt   time.Time
+}
+
+func (suite *StatsTestSuit) SetupSuite() {
+	suite.db = boot.SetUpTestDB()
+
+	reputationContractPackageHash, err := casper.NewContractPackageHash("ea0c001d969da098fefec42b141db88c74c5682e49333ded78035540a0b4f0bc")
+	assert.NoError(suite.T(), err)
+
+	simpleVoterContractPackageHash, err := casper.NewContractPackageHash("954998ff95b0210e994f43f5afb5174b5085fda92d7c63962ef09c17886658c1")
+	assert.NoError(suite.T(), err)
+
+	bidEscrowContractPackageHash, err := casper.NewContractPackageHash("6a3213fe5db928dd4bb3d1c5ecd3bfbc68656823c9486ef389a3080921d0d3ec")
+	assert.NoError(suite.T(), err)
+
+	suite.reputationContractPackageHash = reputationContractPackageHash
+	suite.simpleVoterContractPackageHash = simpleVoterContractPackageHash
+	suite.bidEscrowContractPackageHash = bidEscrowContractPackageHash
+
+	suite.entityManager = persistence.NewEntityManager(suite.db, utils.DAOContractsMetadata{
+		ReputationContractPackageHash:  reputationContractPackageHash,
+		SimpleVoterContractPackageHash: simpleVoterContractPackageHash,
+		BidEscrowContractPackageHash:   bidEscrowContractPackageHash,
+	})
+
+	// Monday, the first day of the week and the month periods
+	suite.informalVotingEndsAt = time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC)
+	suite.formalVotingEndsAt = suite.informalVotingEndsAt.Add(24 * time.Hour)
+}
+
+func (suite *StatsTestSuit) SetupTest() {
+	for _, table := range []string{"votings", "votes", "reputation_changes"} {
+		_, err := suite.db.Exec(`TRUNCATE TABLE ` + table)
+		suite.NoError(err)
+	}
+
+	suite.saveReputationChanges()
+	suite.saveVotings()
+	suite.saveVotes()
+}
+
+// saveReputationChanges stores 2000 of the supply at the end of the informal voting and 1800 at the end of the formal one,
+// the stake legs and the single BidEscrow leg must not change the total
+func (suite *StatsTestSuit) saveReputationChanges() {
+	firstVA := casper.Hash{1}
+	secondVA := casper.Hash{2}
+	votingID := uint32(1)
+
+	mintedAt := suite.informalVotingEndsAt.Add(-2 * time.Hour)
+	stakedAt := suite.informalVotingEndsAt.Add(-time.Hour)
+	settledAt := suite.formalVotingEndsAt.Add(-time.Hour)
+
+	changes := []entities.ReputationChange{
+		entities.NewReputationChange(firstVA, suite.reputationContractPackageHash, nil, 1000, casper.Hash{11}, entities.ReputationChangeReasonMinted, mintedAt),
+		entities.NewReputationChange(secondVA, suite.reputationContractPackageHash, nil, 1000, casper.Hash{12}, entities.ReputationChangeReasonMinted, mintedAt),
+		entities.NewReputationChange(firstVA, suite.reputationContractPackageHash, &votingID, -100, casper.Hash{13}, entities.ReputationChangeReasonStaked, stakedAt),
+		entities.NewReputationChange(firstVA, suite.simpleVoterContractPackageHash, &votingID, 100, casper.Hash{13}, entities.ReputationChangeReasonStaked, stakedAt),
+		entities.NewReputationChange(secondVA, suite.bidEscrowContractPackageHash, nil, 50, casper.Hash{14}, entities.ReputationChangeReasonStaked, stakedAt),
+		entities.NewReputationChange(firstVA, suite.reputationContractPackageHash, &votingID, 100, casper.Hash{15}, entities.ReputationChangeReasonUnstaked, settledAt),
+		entities.NewReputationChange(firstVA, suite.simpleVoterContractPackageHash, &votingID, -100, casper.Hash{15}, entities.ReputationChangeReasonUnstaked, settledAt),
+		entities.NewReputationChange(firstVA, suite.reputationContractPackageHash, &votingID, 200, casper.Hash{15}, entities.ReputationChangeReasonVotingGained, settledAt),
+		entities.NewReputationChange(secondVA, suite.simpleVoterContractPackageHash, &votingID, -100, casper.Hash{15}, entities.ReputationChangeReasonVotingLost, settledAt),
+		entities.NewReputationChange(secondVA, suite.reputationContractPackageHash, nil, -300, casper.Hash{16}, entities.ReputationChangeReasonBurned, settledAt),
+	}
+
+	err := suite.entityManager.ReputationChangeRepository().SaveBatch(changes)
+	require.NoError(suite.T(), err)
+}
+
+func (suite *StatsTestSuit) saveVotings() {
+	informalVotingStartsAt := suite.informalVotingEndsAt.Add(-3 * time.Hour)
+	formalVotingStartsAt := suite.informalVotingEndsAt
+
+	inFavor := entities.VotingResultInFavor
+	against := entities.VotingResultAgainst
+	quorumNotReached := entities.VotingResultQuorumNotReached
+
+	ended := entities.NewVoting(casper.Hash{1}, casper.Hash{21}, 1, entities.VotingTypeSimple, json.RawMessage(`{}`),
+		2, informalVotingStartsAt, suite.informalVotingEndsAt, 2, uint64((24 * time.Hour).Milliseconds()),
+		&formalVotingStartsAt, &suite.formalVotingEndsAt, 4, 8, 0)
+	ended.InformalVotingResult = &inFavor
+	ended.FormalVotingResult = &against
+
+	canceled := entities.NewVoting(casper.Hash{1}, casper.Hash{22}, 2, entities.VotingTypeSimple, json.RawMessage(`{}`),
+		2, informalVotingStartsAt, suite.informalVotingEndsAt, 2, 0, nil, nil, 4, 8, 0)
+	canceled.IsCanceled = true
+
+	missedQuorum := entities.NewVoting(casper.Hash{1}, casper.Hash{23}, 3, entities.VotingTypeRepo, json.RawMessage(`{}`),
+		2, informalVotingStartsAt, suite.informalVotingEndsAt, 2, 0, nil, nil, 4, 8, 0)
+	missedQuorum.InformalVotingResult = &quorumNotReached
+
+	for _, voting := range []entities.Voting{ended, canceled, missedQuorum} {
+		err := suite.entityManager.VotingRepository().Save(&voting)
+		require.NoError(suite.T(), err)
+	}
+}
+
+// saveVotes casts 3 informal ballots out of 4 onboarded VAs with 150 of reputation staked
+// and 2 formal ballots with 300 staked, one of the VAs switches the side
+func (suite *StatsTestSuit) saveVotes() {
+	informalAt := suite.informalVotingEndsAt.Add(-time.Hour)
+	formalAt := suite.formalVotingEndsAt.Add(-2 * time.Hour)
+
+	canceledVote := entities.NewVote(casper.Hash{4}, casper.Hash{34}, 1, 10, entities.StakeTypeIDReputation, true, false, informalAt)
+	canceledVote.IsCanceled = true
+
+	votes := []*entities.Vote{
+		entities.NewVote(casper.Hash{1}, casper.Hash{31}, 1, 100, entities.StakeTypeIDReputation, true, false, informalAt),
+		entities.NewVote(casper.Hash{2}, casper.Hash{32}, 1, 50, entities.StakeTypeIDReputation, false, false, informalAt),
+		entities.NewVote(casper.Hash{3}, casper.Hash{33}, 1, 30, entities.StakeTypeIDCSPR, true, false, informalAt),
+		canceledVote,
+		entities.NewVote(casper.Hash{1}, casper.Hash{35}, 1, 200, entities.StakeTypeIDReputation, false, true, formalAt),
+		entities.NewVote(casper.Hash{2}, casper.Hash{36}, 1, 100, entities.StakeTypeIDReputation, false, true, formalAt),
+		entities.NewVote(casper.Hash{1}, casper.Hash{37}, 2, 100, entities.StakeTypeIDReputation, true, false, informalAt),
+	}
+
+	for _, vote := range votes {
+		err := suite.entityManager.VoteRepository().Save(vote)
+		require.NoError(suite.T(), err)
+	}
+}
+
+func (suite *StatsTestSuit) TestFindVotingParticipation() {
+	participation, err := suite.entityManager.StatsRepository().FindVotingParticipation(&pagination.Params{
+		OrderDirection: pagination.OrderDirectionASC,
+		OrderBy:        []string{"voting_id"},
+		Page:           1,
+		PageSize:       10,
+	}, map[string]interface{}{"voting_id": 1})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(participation))
+
+	voting := participation[0]
+	assert.Equal(suite.T(), uint64(4), voting.TotalOnboarded)
+
+	assert.Equal(suite.T(), uint32(3), voting.InformalVotesNumber)
+	assert.InDelta(suite.T(), 75, *voting.InformalTurnoutPercent, 0.01)
+	assert.Equal(suite.T(), uint64(150), voting.InformalStakedReputation)
+	assert.InDelta(suite.T(), 7.5, *voting.InformalStakedReputationPercent, 0.01)
+
+	assert.Equal(suite.T(), uint32(2), voting.FormalVotesNumber)
+	assert.InDelta(suite.T(), 50, *voting.FormalTurnoutPercent, 0.01)
+	assert.Equal(suite.T(), uint64(300), voting.FormalStakedReputation)
+	assert.InDelta(suite.T(), 16.67, *voting.FormalStakedReputationPercent, 0.01)
+
+	assert.Equal(suite.T(), uint32(2), voting.BothStagesVotersNumber)
+	assert.Equal(suite.T(), uint32(1), voting.SwitchedVotersNumber)
+	assert.InDelta(suite.T(), 50, *voting.SwitchRatePercent, 0.01)
+}
+
+func (suite *StatsTestSuit) TestCalculateVotingTypeParticipation() {
+	participation, err := suite.entityManager.StatsRepository().CalculateVotingTypeParticipation()
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 2, len(participation))
+
+	simple := participation[0]
+	assert.Equal(suite.T(), entities.VotingTypeSimple, simple.VotingTypeID)
+	assert.Equal(suite.T(), uint32(1), simple.VotingsNumber)
+	assert.Equal(suite.T(), uint32(1), simple.InformalEndedNumber)
+	assert.Equal(suite.T(), uint32(0), simple.InformalQuorumMissedNumber)
+	assert.Equal(suite.T(), uint32(1), simple.FormalEndedNumber)
+	assert.InDelta(suite.T(), 75, *simple.AvgInformalTurnoutPercent, 0.01)
+	assert.InDelta(suite.T(), 50, *simple.AvgFormalTurnoutPercent, 0.01)
+	assert.InDelta(suite.T(), 7.5, *simple.AvgInformalStakedReputationPercent, 0.01)
+	assert.InDelta(suite.T(), 16.67, *simple.AvgFormalStakedReputationPercent, 0.01)
+	assert.InDelta(suite.T(), 50, *simple.SwitchRatePercent, 0.01)
+
+	repo := participation[1]
+	assert.Equal(suite.T(), entities.VotingTypeRepo, repo.VotingTypeID)
+	assert.Equal(suite.T(), uint32(1), repo.VotingsNumber)
+	assert.Equal(suite.T(), uint32(1), repo.InformalQuorumMissedNumber)
+	assert.InDelta(suite.T(), 100, *repo.InformalQuorumMissedPercent, 0.01)
+	assert.Equal(suite.T(), uint32(0), repo.FormalEndedNumber)
+	assert.Nil(suite.T(), repo.FormalQuorumMissedPercent)
+	assert.Nil(suite.T(), repo.AvgFormalTurnoutPercent)
+	assert.Nil(suite.T(), repo.SwitchRatePercent)
+}
+
+func (suite *StatsTestSuit) TestCalculateReputationTimeSeries() {
+	stats := suite.entityManager.StatsRepository()
+
+	minted, err := stats.CalculateTimeSeries(entities.TimeSeriesMetricReputationMinted, entities.TimeSeriesIntervalDay, nil, nil)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 2, len(minted))
+	assert.Equal(suite.T(), "2023-01-02", minted[0].PeriodStart.Format("2006-01-02"))
+	assert.Equal(suite.T(), uint64(2000), minted[0].Value)
+	assert.Equal(suite.T(), "2023-01-03", minted[1].PeriodStart.Format("2006-01-02"))
+	assert.Equal(suite.T(), uint64(200), minted[1].Value)
+
+	burned, err := stats.CalculateTimeSeries(entities.TimeSeriesMetricReputationBurned, entities.TimeSeriesIntervalDay, nil, nil)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(burned))
+	assert.Equal(suite.T(), "2023-01-03", burned[0].PeriodStart.Format("2006-01-02"))
+	assert.Equal(suite.T(), uint64(400), burned[0].Value)
+
+	weekly, err := stats.CalculateTimeSeries(entities.TimeSeriesMetricReputationMinted, entities.TimeSeriesIntervalWeek, nil, nil)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(weekly))
+	assert.Equal(suite.T(), "2023-01-02", weekly[0].PeriodStart.Format("2006-01-02"))
+	assert.Equal(suite.T(), uint64(2200), weekly[0].Value)
+
+	from := suite.formalVotingEndsAt.Truncate(24 * time.Hour)
+	filtered, err := stats.CalculateTimeSeries(entities.TimeSeriesMetricReputationMinted, entities.TimeSeriesIntervalDay, &from, nil)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(filtered))
+	assert.Equal(suite.T(), uint64(200), filtered[0].Value)
+}
+
+func TestStatsTestSuit(t *testing.T) {
+	suite.Run(t, new(StatsTestSuit))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"errors"
 	"strconv"
 )
 
@@ -23,13 +24,24 @@ type Record struct {
 }
 
 func NewRecordValueFromBytesWithReminder(rawBytes []byte) (RecordValue, []byte, error) {
+	if len(rawBytes) < 4 {
+		return RecordValue{}, nil, errors.New("invalid record value bytes length")
+	}
+
 	numBytes := binary.LittleEndian.Uint32(rawBytes)
 	// shift 4 bytes (uint32)
 	reminder := rawBytes[4:]
 
+	if uint64(numBytes) > uint64(len(reminder)) {
+		return RecordValue{}, nil, errors.New("invalid record value bytes: number_bytes is more than bytes slice")
+	}
+
+	valueBytes := reminder[:numBytes]
+	reminder = reminder[numBytes:]
+
 	// length 33 represent Key CLValue
 	if numBytes == 33 {
-		key, reminder, err := ParseKeyFromBytes(reminder)
+		key, _, err := ParseKeyFromBytes(valueBytes)
 		if err != nil {
 			return RecordValue{}, nil, err
 		}
@@ -52,28 +64,28 @@ func NewRecordValueFromBytesWithReminder(rawBytes []byte) (RecordValue, []byte,
 	// 8 0 0 0  ==> numBytes + 7  ==> numBytes of internal data + internal data(7 bytes)
 	// U256/U512 =  8 0 0 0 7 1 1 1 1 1 1 1
 	// u64 =  8 0 0 0 1 1 1 1 1 1 1 1
-	if numBytes == 8 && reminder[0] != 7 {
-		val := binary.LittleEndian.Uint64(reminder)
+	if numBytes == 8 && valueBytes[0] != 7 {
+		val := binary.LittleEndian.Uint64(valueBytes)
 		return RecordValue{
 			U64Value: &val,
-		}, reminder[8:], nil
+		}, reminder, nil
 	}
 
 	if numBytes == 1 {
-		boolVal := reminder[0] == 1
+		boolVal := valueBytes[0] == 1
 		return RecordValue{
 			BoolValue: &boolVal,
-		}, reminder[1:], nil
+		}, reminder, nil
 	}
 
-	val, _, err := ParseUTypeFromBytes[U256](reminder)
+	val, _, err := ParseUTypeFromBytes[U256](valueBytes)
 	if err != nil {
 		return RecordValue{}, nil, err
 	}
 
 	return RecordValue{
 		UValue: &val,
-	}, reminder[numBytes:], nil
+	}, reminder, nil
 }
 
 func NewRecordValueFromBytes(rawBytes []byte) (RecordValue, error) {
@@ -128,6 +140,43 @@ func NewRecordValueFromBytes(rawBytes []byte) (RecordValue, error) {
 	}, nil
 }
 
+// NewRecordFromBytes parses Variable Repository storage Record serialized as (Bytes, Option<(Bytes, u64)>)
+func NewRecordFromBytes(rawBytes []byte) (Record, error) {
+	if len(rawBytes) < 4 {
+		return Record{}, errors.New("invalid record bytes length")
+	}
+
+	value, reminder, err := NewRecordValueFromBytesWithReminder(rawBytes)
+	if err != nil {
+		return Record{}, err
+	}
+
+	record := Record{
+		Value: value,
+	}
+
+	// Option(None) of the future value
+	if len(reminder) == 0 || reminder[0] == 0 {
+		return record, nil
+	}
+
+	futureValue, reminder, err := NewRecordValueFromBytesWithReminder(reminder[1:])
+	if err != nil {
+		return Record{}, err
+	}
+
+	if len(reminder) < 8 {
+		return Record{}, errors.New("invalid future value activation time length")
+	}
+
+	record.FutureValue = &FutureValue{
+		Value:          futureValue,
+		ActivationTime: binary.LittleEndian.Uint64(reminder),
+	}
+
+	return record, nil
+}
+
 func (r RecordValue) String() string {
 	switch {
 	case r.UValue != nil:
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRecordFromBytes(t *testing.T) {
+	u64Value := []byte{8, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0}
+
+	t.Run("u64 value without future value", func(t *testing.T) {
+		record, err := NewRecordFromBytes(append(u64Value, 0))
+		assert.NoError(t, err)
+
+		assert.NotNil(t, record.Value.U64Value)
+		assert.Equal(t, uint64(5), *record.Value.U64Value)
+		assert.Nil(t, record.FutureValue)
+	})
+
+	t.Run("u64 value with future value", func(t *testing.T) {
+		rawBytes := append([]byte{}, u64Value...)
+		rawBytes = append(rawBytes, 1)
+		rawBytes = append(rawBytes, 8, 0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0)
+		rawBytes = append(rawBytes, 0xe8, 0x03, 0, 0, 0, 0, 0, 0)
+
+		record, err := NewRecordFromBytes(rawBytes)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "5", record.Value.String())
+		assert.NotNil(t, record.FutureValue)
+		assert.Equal(t, "10", record.FutureValue.Value.String())
+		assert.Equal(t, uint64(1000), record.FutureValue.ActivationTime)
+	})
+
+	t.Run("key value", func(t *testing.T) {
+		hash := bytes.Repeat([]byte{1}, 32)
+		rawBytes := append([]byte{33, 0, 0, 0, byte(KeyAccount)}, hash...)
+		rawBytes = append(rawBytes, 0)
+
+		record, err := NewRecordFromBytes(rawBytes)
+		assert.NoError(t, err)
+
+		assert.NotNil(t, record.Value.Address)
+		assert.NotNil(t, record.Value.Address.AccountHash)
+		assert.Equal(t, hash, record.Value.Address.AccountHash.Bytes())
+	})
+
+	t.Run("bool value", func(t *testing.T) {
+		record, err := NewRecordFromBytes([]byte{1, 0, 0, 0, 1, 0})
+		assert.NoError(t, err)
+
+		assert.NotNil(t, record.Value.BoolValue)
+		assert.True(t, *record.Value.BoolValue)
+	})
+
+	t.Run("u256 value coded in 8 bytes", func(t *testing.T) {
+		record, err := NewRecordFromBytes([]byte{8, 0, 0, 0, 7, 1, 0, 0, 0, 0, 0, 0, 0})
+		assert.NoError(t, err)
+
+		assert.Nil(t, record.Value.U64Value)
+		assert.NotNil(t, record.Value.UValue)
+		assert.Equal(t, "1", record.Value.String())
+	})
+
+	t.Run("u256 value", func(t *testing.T) {
+		record, err := NewRecordFromBytes([]byte{3, 0, 0, 0, 2, 0xe8, 0x03, 0})
+		assert.NoError(t, err)
+
+		assert.NotNil(t, record.Value.UValue)
+		assert.Equal(t, "1000", record.Value.String())
+	})
+
+	t.Run("truncated input", func(t *testing.T) {
+		truncated := map[string][]byte{
+			"empty":                          {},
+			"value length":                   {8, 0},
+			"u64 value":                      {8, 0, 0, 0, 5, 0, 0},
+			"empty u64 value":                {8, 0, 0, 0},
+			"key value":                      {33, 0, 0, 0, byte(KeyAccount), 1, 1},
+			"u256 value":                     {3, 0, 0, 0, 3, 0xe8, 0x03},
+			"future value length":            append(append([]byte{}, u64Value...), 1, 8, 0),
+			"future value":                   append(append([]byte{}, u64Value...), 1, 8, 0, 0, 0, 10),
+			"future value activation time":   append(append([]byte{}, u64Value...), 1, 8, 0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0xe8),
+			"future value without any bytes": append(append([]byte{}, u64Value...), 1),
+		}
+
+		for name, rawBytes := range truncated {
+			assert.NotPanics(t, func() {
+				_, err := NewRecordFromBytes(rawBytes)
+				assert.Error(t, err, name)
+			}, name)
+		}
+	})
+}
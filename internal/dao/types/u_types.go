@@ -25,7 +25,7 @@ func ParseUTypeFromBytes[T UType](bytes []byte) (T, []byte, error) {
 
 	// read first bytes as bytes number
 	numBytes := bytes[0]
-	if int(numBytes) > len(bytes) {
+	if int(numBytes) > len(bytes)-1 {
 		return T{}, nil, errors.New("invalid bytes format: number_bytes is more than bytes slice")
 	}
 
@@ -51,7 +51,7 @@ func ParseStringFromBytes(bytes []byte) (string, []byte, error) {
 	}
 
 	numBytes := binary.LittleEndian.Uint32(bytes)
-	if int(numBytes) > len(bytes) {
+	if int(numBytes) > len(bytes)-1 {
 		return "nil", nil, errors.New("invalid bytes format: number_bytes is more than bytes slice")
 	}
 	// shift to 4 bytes (unit32)
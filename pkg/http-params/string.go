@@ -17,3 +17,16 @@ func ParseString(key string, r *http.Request) (string, error) {
 
 	return rawParam, nil
 }
+
+func ParseOptionalString(key string, r *http.Request) (*string, error) {
+	rawParam, ok := getParamByKey(key, r)
+	if !ok {
+		return nil, nil
+	}
+
+	if rawParam == "" {
+		return nil, errors.NewInvalidInputError(fmt.Sprintf("Empty `%s` value", key))
+	}
+
+	return &rawParam, nil
+}
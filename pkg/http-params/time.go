@@ -73,3 +73,17 @@ func ParseOptionalTimeList(key string, r *http.Request) ([]time.Time, error) {
 	}
 	return res, nil
 }
+
+func ParseOptionalTime(key string, r *http.Request) (*time.Time, error) {
+	rawTime, ok := getParamByKey(key, r)
+	if !ok {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, rawTime)
+	if err != nil {
+		return nil, errors.NewInvalidInputError(fmt.Sprintf("Invalid `%s` format should be %s", key, time.RFC3339))
+	}
+
+	return &parsed, nil
+}
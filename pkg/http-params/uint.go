@@ -22,6 +22,20 @@ func ParseUint64(key string, r *http.Request) (uint64, error) {
 	return param, nil
 }
 
+func ParseOptionalUint64(key string, r *http.Request) (*uint64, error) {
+	stringParam, ok := getParamByKey(key, r)
+	if !ok {
+		return nil, nil
+	}
+
+	param, err := strconv.ParseUint(stringParam, 10, 0)
+	if err != nil {
+		return nil, errors.NewInvalidInputError(fmt.Sprintf("Invalid `%s` format", key))
+	}
+
+	return &param, nil
+}
+
 func ParseUint16(key string, r *http.Request) (uint16, error) {
 	stringParam, ok := getParamByKey(key, r)
 	if !ok {
@@ -51,6 +51,35 @@ func TestParseUint64(t *testing.T) {
 	})
 }
 
+func TestParseOptionalUint64(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "http://localhost/tests?param=18446744073709551615", nil)
+		assert.NoError(t, err)
+
+		actualUint, err := ParseOptionalUint64("param", req)
+		assert.NoError(t, err)
+		assert.Equal(t, *actualUint, uint64(18446744073709551615))
+	})
+
+	t.Run("No param provided", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "http://localhost/tests", nil)
+		assert.NoError(t, err)
+
+		actualUint, err := ParseOptionalUint64("param", req)
+		assert.NoError(t, err)
+		assert.Nil(t, actualUint)
+	})
+
+	t.Run("Fail: invalid `param` format", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "http://localhost/tests?param=-1", nil)
+		assert.NoError(t, err)
+
+		_, err = ParseOptionalUint64("param", req)
+		assert.Error(t, err)
+		assert.Equal(t, err.Error(), "Invalid `param` format")
+	})
+}
+
 func TestParseOptionalUint32List(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		req, err := http.NewRequest(http.MethodGet, "http://localhost/tests?param=100,123", nil)
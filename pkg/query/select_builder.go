@@ -19,7 +19,25 @@ func Select(columns ...string) *SelectBuilder {
 }
 
 func (b *SelectBuilder) Where(pred interface{}, args ...interface{}) *SelectBuilder {
-	b.inner = b.inner.Where(pred, args)
+	b.inner = b.inner.Where(pred, args...)
+	return b
+}
+
+// Column adds a result column to the query.
+func (b *SelectBuilder) Column(column interface{}, args ...interface{}) *SelectBuilder {
+	b.inner = b.inner.Column(column, args...)
+	return b
+}
+
+// Join adds a JOIN clause to the query.
+func (b *SelectBuilder) Join(join string, rest ...interface{}) *SelectBuilder {
+	b.inner = b.inner.Join(join, rest...)
+	return b
+}
+
+// LeftJoin adds a LEFT JOIN clause to the query.
+func (b *SelectBuilder) LeftJoin(join string, rest ...interface{}) *SelectBuilder {
+	b.inner = b.inner.LeftJoin(join, rest...)
 	return b
 }
 
@@ -36,6 +36,13 @@ func Union(a sq.SelectBuilder, b sq.SelectBuilder) UnionBuilder {
 	return ub.Union(b)
 }
 
+// UnionAll is the same as Union but keeps duplicated rows of the selects
+func UnionAll(a sq.SelectBuilder, b sq.SelectBuilder) UnionBuilder {
+	ub := UnionBuilder{}
+	ub = ub.setFirstSelect(a)
+	return ub.UnionAll(b)
+}
+
 func (u UnionBuilder) ToSql() (sql string, args []interface{}, err error) {
 	builderStruct := builder.GetStruct(u)
 
@@ -92,6 +99,13 @@ func (u UnionBuilder) Union(selector sq.SelectBuilder) UnionBuilder {
 	return builder.Append(u, "Selects", &unionSelect{op: "UNION", selector: selector}).(UnionBuilder)
 }
 
+func (u UnionBuilder) UnionAll(selector sq.SelectBuilder) UnionBuilder {
+	// use ? in children to prevent numbering issues
+	selector = selector.PlaceholderFormat(sq.Question)
+
+	return builder.Append(u, "Selects", &unionSelect{op: "UNION ALL", selector: selector}).(UnionBuilder)
+}
+
 func (u UnionBuilder) setFirstSelect(selector sq.SelectBuilder) UnionBuilder {
 
 	// copy the PlaceholderFormat value from children since we don't know what it should be
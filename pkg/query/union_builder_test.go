@@ -0,0 +1,38 @@
+package query
+
+import (
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+
+	"casper-dao-middleware/pkg/pagination"
+)
+
+func TestUnionBuilder(t *testing.T) {
+	t.Run("Union All with pagination", func(t *testing.T) {
+		a := sq.Select("id", "timestamp").From("a").Where(sq.Eq{"address": "x"})
+		b := sq.Select("id", "timestamp").From("b").Where(sq.Eq{"address": "y"})
+
+		params := &pagination.Params{
+			Page:           2,
+			PageSize:       10,
+			OrderBy:        []string{"timestamp", "unknown"},
+			OrderDirection: pagination.OrderDirectionDESC,
+		}
+
+		sql, args, err := UnionAll(a, b).
+			Paginate(params, map[string]struct{}{"timestamp": {}}).
+			ToSql()
+		assert.NoError(t, err)
+		assert.Equal(t,
+			"SELECT id, timestamp FROM a WHERE address = ? UNION ALL ( SELECT id, timestamp FROM b WHERE address = ? )  ORDER BY timestamp desc LIMIT 10 OFFSET 10",
+			sql)
+		assert.Equal(t, []interface{}{"x", "y"}, args)
+	})
+
+	t.Run("Fail: no selects", func(t *testing.T) {
+		_, _, err := UnionBuilder{}.ToSql()
+		assert.Error(t, err)
+	})
+}